@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/manage-accounts-nerdgraph/
+
+// AccountParameters are the configurable fields of an Account.
+type AccountParameters struct {
+	// The account name. An existing account of the organization with this name is adopted.
+	Name string `json:"name"`
+
+	// The data center region of the account.
+	// +kubebuilder:validation:Enum=us01;eu01
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="regionCode is immutable"
+	// +kubebuilder:default=us01
+	// +optional
+	RegionCode string `json:"regionCode,omitempty"`
+}
+
+// A ProviderConfigDestination names the ProviderConfig written for an account.
+type ProviderConfigDestination struct {
+	// Name of the ProviderConfig.
+	Name string `json:"name"`
+}
+
+// AccountObservation are the observable fields of an Account.
+type AccountObservation struct {
+	// The account ID.
+	ID int `json:"id,omitempty"`
+	// The data center region of the account.
+	RegionCode string `json:"regionCode,omitempty"`
+}
+
+// An AccountSpec defines the desired state of an Account.
+type AccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountParameters `json:"forProvider"`
+
+	// WriteProviderConfigTo creates a ProviderConfig targeting the new account with the
+	// credentials of the ProviderConfig of this resource, so other resources can use
+	// the account right away.
+	// +optional
+	WriteProviderConfigTo *ProviderConfigDestination `json:"writeProviderConfigTo,omitempty"`
+}
+
+// An AccountStatus represents the observed state of an Account.
+type AccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Account is a New Relic sub-account of an organization. Accounts can't be deleted
+// through the API, so deleting the resource leaves the account in place.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type Account struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountSpec   `json:"spec"`
+	Status AccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountList contains a list of Account
+type AccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Account `json:"items"`
+}
@@ -25,6 +25,12 @@ import (
 
 // AlertsPolicyParameters - Container for conditions with associated notifications channels.
 type AlertsPolicyParameters struct {
+	// ID of the account the policy belongs to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
 	// Primary key for policies.
 	ID string `json:"id,omitempty"`
 	// Determines how incidents are created for critical violations of the conditions contained in the policy.
@@ -71,18 +77,6 @@ type AlertsPolicy struct {
 	Status AlertsPolicyStatus `json:"status,omitempty"`
 }
 
-// SetPublishConnectionDetailsTo is a func for connection details
-func (in *AlertsPolicy) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
-	// TODO implement me
-	panic("implement me")
-}
-
-// GetPublishConnectionDetailsTo is a func for connection details
-func (in *AlertsPolicy) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
-	// TODO implement me
-	panic("implement me")
-}
-
 // +kubebuilder:object:root=true
 
 // AlertsPolicyList contains a list of AlertsPolicy
@@ -101,6 +101,11 @@ func (in *AlertsPolicyObservation) DeepCopy() *AlertsPolicyObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertsPolicyParameters) DeepCopyInto(out *AlertsPolicyParameters) {
 	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
 	if in.ChannelIDs != nil {
 		in, out := &in.ChannelIDs, &out.ChannelIDs
 		*out = make([]int, len(*in))
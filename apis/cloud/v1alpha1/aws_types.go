@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-cloud-integrations-api-tutorial/
+
+// CloudAwsLinkAccountParameters are the configurable fields of a CloudAwsLinkAccount.
+type CloudAwsLinkAccountParameters struct {
+	// ID of the New Relic account the AWS account is linked to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// The linked account name.
+	Name string `json:"name"`
+
+	// The AWS role ARN New Relic assumes to fetch data. It cannot be changed
+	// once the account is linked.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="arn is immutable"
+	Arn string `json:"arn,omitempty"`
+
+	// ArnRef reads the role ARN from another Kubernetes object, for example
+	// an IAM Role managed by provider-aws.
+	// +optional
+	ArnRef *ObjectFieldReference `json:"arnRef,omitempty"`
+
+	// How metrics will be collected. PULL polls the AWS APIs, PUSH expects
+	// a CloudWatch metric stream. It can only be set when the account is linked.
+	// +kubebuilder:validation:Enum=PULL;PUSH
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="metricCollectionMode is immutable"
+	// +kubebuilder:default=PULL
+	// +optional
+	MetricCollectionMode string `json:"metricCollectionMode,omitempty"`
+}
+
+// CloudAwsLinkAccountObservation are the observable fields of a CloudAwsLinkAccount.
+type CloudAwsLinkAccountObservation struct {
+	// The linked account identifier in New Relic.
+	ID int `json:"id,omitempty"`
+	// The AWS account ID.
+	ExternalID string `json:"externalId,omitempty"`
+	// The role ARN used to fetch data.
+	AuthLabel string `json:"authLabel,omitempty"`
+	// Indicates whether the linked account is disabled.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// A CloudAwsLinkAccountSpec defines the desired state of a CloudAwsLinkAccount.
+type CloudAwsLinkAccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudAwsLinkAccountParameters `json:"forProvider"`
+}
+
+// A CloudAwsLinkAccountStatus represents the observed state of a CloudAwsLinkAccount.
+type CloudAwsLinkAccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudAwsLinkAccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudAwsLinkAccount links an AWS account to New Relic.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type CloudAwsLinkAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudAwsLinkAccountSpec   `json:"spec"`
+	Status CloudAwsLinkAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudAwsLinkAccountList contains a list of CloudAwsLinkAccount
+type CloudAwsLinkAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudAwsLinkAccount `json:"items"`
+}
+
+// CloudAwsIntegrationsParameters are the configurable fields of a CloudAwsIntegrations.
+type CloudAwsIntegrationsParameters struct {
+	// ID of the New Relic account the linked account belongs to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// The linked account identifier in New Relic.
+	// +optional
+	LinkedAccountID *int64 `json:"linkedAccountId,omitempty"`
+
+	// LinkedAccountRef is a reference to a CloudAwsLinkAccount used to set
+	// the LinkedAccountID.
+	// +optional
+	LinkedAccountRef *xpv1.Reference `json:"linkedAccountRef,omitempty"`
+
+	// LinkedAccountSelector selects references to a CloudAwsLinkAccount used
+	// to set the LinkedAccountID.
+	// +optional
+	LinkedAccountSelector *xpv1.Selector `json:"linkedAccountSelector,omitempty"`
+
+	// Integrations enabled on the linked account. Integrations that are not
+	// listed are disabled.
+	// +listType=map
+	// +listMapKey=service
+	Integrations []AwsIntegration `json:"integrations"`
+}
+
+// AwsIntegration configures the polling of a single AWS service.
+type AwsIntegration struct {
+	// Service is the NerdGraph name of the integration.
+	// +kubebuilder:validation:Enum=apigateway;alb;autoscaling;awsAppsync;awsAthena;awsCognito;awsConnect;awsDirectconnect;awsDocdb;awsFsx;awsGlue;awsKinesisanalytics;awsMediaconvert;awsMediapackagevod;awsMetadata;awsMq;awsMsElasticache;awsMsk;awsNeptune;awsQldb;awsRoute53resolver;awsStates;awsTagsGlobal;awsTransitgateway;awsWaf;awsWafv2;awsXray;billing;cloudfront;cloudtrail;dynamodb;ebs;ec2;ecs;efs;elasticache;elasticbeanstalk;elasticsearch;elb;emr;health;iam;iot;kinesis;kinesisFirehose;lambda;rds;redshift;route53;s3;ses;sns;sqs;trustedadvisor;vpc
+	Service string `json:"service"`
+
+	// The data polling interval in seconds.
+	// +optional
+	MetricsPollingInterval *int `json:"metricsPollingInterval,omitempty"`
+
+	// AWS regions that include the resources to monitor.
+	// +optional
+	AwsRegions []string `json:"awsRegions,omitempty"`
+
+	// Whether tags should be collected. Ignored by services that do not support it.
+	// +optional
+	FetchTags *bool `json:"fetchTags,omitempty"`
+
+	// Whether extra inventory data should be collected. Ignored by services that do not support it.
+	// +optional
+	FetchExtendedInventory *bool `json:"fetchExtendedInventory,omitempty"`
+
+	// Only monitor resources with this tag key. Ignored by services that do not support it.
+	// +optional
+	TagKey *string `json:"tagKey,omitempty"`
+
+	// Only monitor resources with this tag value. Ignored by services that do not support it.
+	// +optional
+	TagValue *string `json:"tagValue,omitempty"`
+}
+
+// CloudAwsIntegrationsObservation are the observable fields of a CloudAwsIntegrations.
+type CloudAwsIntegrationsObservation struct {
+	// Integrations currently enabled on the linked account.
+	Integrations []CloudIntegrationObservation `json:"integrations,omitempty"`
+}
+
+// A CloudAwsIntegrationsSpec defines the desired state of a CloudAwsIntegrations.
+type CloudAwsIntegrationsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudAwsIntegrationsParameters `json:"forProvider"`
+}
+
+// A CloudAwsIntegrationsStatus represents the observed state of a CloudAwsIntegrations.
+type CloudAwsIntegrationsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudAwsIntegrationsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudAwsIntegrations configures which AWS services New Relic polls for a linked account.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="LINKED-ACCOUNT",type="string",JSONPath=".spec.forProvider.linkedAccountId"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type CloudAwsIntegrations struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudAwsIntegrationsSpec   `json:"spec"`
+	Status CloudAwsIntegrationsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudAwsIntegrationsList contains a list of CloudAwsIntegrations
+type CloudAwsIntegrationsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudAwsIntegrations `json:"items"`
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-cloud-integrations-api-tutorial/
+
+// CloudAzureLinkAccountParameters are the configurable fields of a CloudAzureLinkAccount.
+type CloudAzureLinkAccountParameters struct {
+	// ID of the New Relic account the Azure subscription is linked to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// The linked account name.
+	Name string `json:"name"`
+
+	// The Azure application (client) identifier used to fetch data.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="applicationId is immutable"
+	ApplicationID string `json:"applicationId"`
+
+	// ClientSecretRef references the key of a Secret holding the Azure
+	// application secret. It is only read when the account is linked.
+	ClientSecretRef xpv1.SecretKeySelector `json:"clientSecretRef"`
+
+	// The Azure subscription identifier.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="subscriptionId is immutable"
+	SubscriptionID string `json:"subscriptionId"`
+
+	// The Azure tenant identifier.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tenantId is immutable"
+	TenantID string `json:"tenantId"`
+}
+
+// CloudAzureLinkAccountObservation are the observable fields of a CloudAzureLinkAccount.
+type CloudAzureLinkAccountObservation struct {
+	// The linked account identifier in New Relic.
+	ID int `json:"id,omitempty"`
+	// The Azure subscription identifier.
+	ExternalID string `json:"externalId,omitempty"`
+	// The application identifier used to fetch data.
+	AuthLabel string `json:"authLabel,omitempty"`
+	// Indicates whether the linked account is disabled.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// A CloudAzureLinkAccountSpec defines the desired state of a CloudAzureLinkAccount.
+type CloudAzureLinkAccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudAzureLinkAccountParameters `json:"forProvider"`
+}
+
+// A CloudAzureLinkAccountStatus represents the observed state of a CloudAzureLinkAccount.
+type CloudAzureLinkAccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudAzureLinkAccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudAzureLinkAccount links an Azure subscription to New Relic.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type CloudAzureLinkAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudAzureLinkAccountSpec   `json:"spec"`
+	Status CloudAzureLinkAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudAzureLinkAccountList contains a list of CloudAzureLinkAccount
+type CloudAzureLinkAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudAzureLinkAccount `json:"items"`
+}
+
+// CloudAzureIntegrationsParameters are the configurable fields of a CloudAzureIntegrations.
+type CloudAzureIntegrationsParameters struct {
+	// ID of the New Relic account the linked account belongs to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// The linked account identifier in New Relic.
+	// +optional
+	LinkedAccountID *int64 `json:"linkedAccountId,omitempty"`
+
+	// LinkedAccountRef is a reference to a CloudAzureLinkAccount used to set
+	// the LinkedAccountID.
+	// +optional
+	LinkedAccountRef *xpv1.Reference `json:"linkedAccountRef,omitempty"`
+
+	// LinkedAccountSelector selects references to a CloudAzureLinkAccount used
+	// to set the LinkedAccountID.
+	// +optional
+	LinkedAccountSelector *xpv1.Selector `json:"linkedAccountSelector,omitempty"`
+
+	// Integrations enabled on the linked account. Integrations that are not
+	// listed are disabled.
+	// +listType=map
+	// +listMapKey=service
+	Integrations []AzureIntegration `json:"integrations"`
+}
+
+// AzureIntegration configures the polling of a single Azure service.
+type AzureIntegration struct {
+	// Service is the NerdGraph name of the integration.
+	// +kubebuilder:validation:Enum=azureApimanagement;azureAppgateway;azureAppservice;azureContainers;azureCosmosdb;azureCostmanagement;azureDatafactory;azureEventhub;azureExpressroute;azureFirewalls;azureFrontdoor;azureFunctions;azureKeyvault;azureLoadbalancer;azureLogicapps;azureMachinelearning;azureMariadb;azureMonitor;azureMysql;azureMysqlflexible;azurePostgresql;azurePostgresqlflexible;azurePowerbidedicated;azureRediscache;azureServicebus;azureSql;azureSqlmanaged;azureStorage;azureVirtualmachine;azureVirtualnetworks;azureVms;azureVpngateways
+	Service string `json:"service"`
+
+	// The data polling interval in seconds.
+	// +optional
+	MetricsPollingInterval *int `json:"metricsPollingInterval,omitempty"`
+
+	// Resource groups that include the resources to monitor.
+	// +optional
+	ResourceGroups []string `json:"resourceGroups,omitempty"`
+
+	// Resource tags, in key:value form, of the resources to monitor. Only supported by azureMonitor.
+	// +optional
+	IncludeTags []string `json:"includeTags,omitempty"`
+
+	// Resource tags, in key:value form, of the resources to exclude. Only supported by azureMonitor.
+	// +optional
+	ExcludeTags []string `json:"excludeTags,omitempty"`
+
+	// Azure resource types to monitor. Only supported by azureMonitor.
+	// +optional
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+}
+
+// CloudAzureIntegrationsObservation are the observable fields of a CloudAzureIntegrations.
+type CloudAzureIntegrationsObservation struct {
+	// Integrations currently enabled on the linked account.
+	Integrations []CloudIntegrationObservation `json:"integrations,omitempty"`
+}
+
+// A CloudAzureIntegrationsSpec defines the desired state of a CloudAzureIntegrations.
+type CloudAzureIntegrationsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudAzureIntegrationsParameters `json:"forProvider"`
+}
+
+// A CloudAzureIntegrationsStatus represents the observed state of a CloudAzureIntegrations.
+type CloudAzureIntegrationsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudAzureIntegrationsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudAzureIntegrations configures which Azure services New Relic polls for a linked account.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="LINKED-ACCOUNT",type="string",JSONPath=".spec.forProvider.linkedAccountId"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type CloudAzureIntegrations struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudAzureIntegrationsSpec   `json:"spec"`
+	Status CloudAzureIntegrationsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudAzureIntegrationsList contains a list of CloudAzureIntegrations
+type CloudAzureIntegrationsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudAzureIntegrations `json:"items"`
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-cloud-integrations-api-tutorial/
+
+// CloudGcpLinkAccountParameters are the configurable fields of a CloudGcpLinkAccount.
+type CloudGcpLinkAccountParameters struct {
+	// ID of the New Relic account the GCP project is linked to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// The linked account name.
+	Name string `json:"name"`
+
+	// The GCP project identifier. New Relic's service account must have
+	// the Project Viewer role on the project.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="projectId is immutable"
+	ProjectID string `json:"projectId"`
+}
+
+// CloudGcpLinkAccountObservation are the observable fields of a CloudGcpLinkAccount.
+type CloudGcpLinkAccountObservation struct {
+	// The linked account identifier in New Relic.
+	ID int `json:"id,omitempty"`
+	// The GCP project identifier.
+	ExternalID string `json:"externalId,omitempty"`
+	// The service account used to fetch data.
+	AuthLabel string `json:"authLabel,omitempty"`
+	// Indicates whether the linked account is disabled.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// A CloudGcpLinkAccountSpec defines the desired state of a CloudGcpLinkAccount.
+type CloudGcpLinkAccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudGcpLinkAccountParameters `json:"forProvider"`
+}
+
+// A CloudGcpLinkAccountStatus represents the observed state of a CloudGcpLinkAccount.
+type CloudGcpLinkAccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudGcpLinkAccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudGcpLinkAccount links a GCP project to New Relic.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type CloudGcpLinkAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudGcpLinkAccountSpec   `json:"spec"`
+	Status CloudGcpLinkAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudGcpLinkAccountList contains a list of CloudGcpLinkAccount
+type CloudGcpLinkAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudGcpLinkAccount `json:"items"`
+}
+
+// CloudGcpIntegrationsParameters are the configurable fields of a CloudGcpIntegrations.
+type CloudGcpIntegrationsParameters struct {
+	// ID of the New Relic account the linked account belongs to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// The linked account identifier in New Relic.
+	// +optional
+	LinkedAccountID *int64 `json:"linkedAccountId,omitempty"`
+
+	// LinkedAccountRef is a reference to a CloudGcpLinkAccount used to set
+	// the LinkedAccountID.
+	// +optional
+	LinkedAccountRef *xpv1.Reference `json:"linkedAccountRef,omitempty"`
+
+	// LinkedAccountSelector selects references to a CloudGcpLinkAccount used
+	// to set the LinkedAccountID.
+	// +optional
+	LinkedAccountSelector *xpv1.Selector `json:"linkedAccountSelector,omitempty"`
+
+	// Integrations enabled on the linked account. Integrations that are not
+	// listed are disabled.
+	// +listType=map
+	// +listMapKey=service
+	Integrations []GcpIntegration `json:"integrations"`
+}
+
+// GcpIntegration configures the polling of a single GCP service.
+type GcpIntegration struct {
+	// Service is the NerdGraph name of the integration.
+	// +kubebuilder:validation:Enum=gcpAiplatform;gcpAlloydb;gcpAppengine;gcpBigquery;gcpBigtable;gcpComposer;gcpDataflow;gcpDataproc;gcpDatastore;gcpFirebasedatabase;gcpFirebasehosting;gcpFirebasestorage;gcpFirestore;gcpFunctions;gcpInterconnect;gcpKubernetes;gcpLoadbalancing;gcpMemcache;gcpPubsub;gcpRedis;gcpRouter;gcpRun;gcpSpanner;gcpSql;gcpStorage;gcpVms;gcpVpcaccess
+	Service string `json:"service"`
+
+	// The data polling interval in seconds.
+	// +optional
+	MetricsPollingInterval *int `json:"metricsPollingInterval,omitempty"`
+
+	// Whether labels and tags should be collected. Ignored by services that do not support it.
+	// +optional
+	FetchTags *bool `json:"fetchTags,omitempty"`
+
+	// Whether table metrics should be collected. Only supported by gcpBigquery.
+	// +optional
+	FetchTableMetrics *bool `json:"fetchTableMetrics,omitempty"`
+}
+
+// CloudGcpIntegrationsObservation are the observable fields of a CloudGcpIntegrations.
+type CloudGcpIntegrationsObservation struct {
+	// Integrations currently enabled on the linked account.
+	Integrations []CloudIntegrationObservation `json:"integrations,omitempty"`
+}
+
+// A CloudGcpIntegrationsSpec defines the desired state of a CloudGcpIntegrations.
+type CloudGcpIntegrationsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudGcpIntegrationsParameters `json:"forProvider"`
+}
+
+// A CloudGcpIntegrationsStatus represents the observed state of a CloudGcpIntegrations.
+type CloudGcpIntegrationsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudGcpIntegrationsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudGcpIntegrations configures which GCP services New Relic polls for a linked account.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="LINKED-ACCOUNT",type="string",JSONPath=".spec.forProvider.linkedAccountId"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type CloudGcpIntegrations struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudGcpIntegrationsSpec   `json:"spec"`
+	Status CloudGcpIntegrationsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudGcpIntegrationsList contains a list of CloudGcpIntegrations
+type CloudGcpIntegrationsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudGcpIntegrations `json:"items"`
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultFieldPath = "status.atProvider.arn"
+
+// referenceableKinds are the kinds an ObjectFieldReference may point at. The
+// package requests permission to read them, see package/crossplane.yaml.
+var referenceableKinds = map[schema.GroupKind]bool{
+	{Group: "iam.aws.upbound.io", Kind: "Role"}:    true,
+	{Group: "iam.aws.crossplane.io", Kind: "Role"}: true,
+}
+
+// ResolveReferences of this CloudAwsLinkAccount
+func (mg *CloudAwsLinkAccount) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.ArnRef == nil {
+		return nil
+	}
+
+	arn, err := ResolveObjectField(ctx, c, mg.Spec.ForProvider.ArnRef)
+	if err != nil {
+		return errors.Wrap(err, "Spec.ForProvider.Arn")
+	}
+	mg.Spec.ForProvider.Arn = arn
+
+	return nil
+}
+
+// ResolveReferences of this CloudAwsIntegrations
+func (mg *CloudAwsIntegrations) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromIntPtrValue(mg.Spec.ForProvider.LinkedAccountID),
+		Reference:    mg.Spec.ForProvider.LinkedAccountRef,
+		Selector:     mg.Spec.ForProvider.LinkedAccountSelector,
+		To:           reference.To{Managed: &CloudAwsLinkAccount{}, List: &CloudAwsLinkAccountList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Spec.ForProvider.LinkedAccountID")
+	}
+
+	mg.Spec.ForProvider.LinkedAccountID = reference.ToIntPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LinkedAccountRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CloudGcpIntegrations
+func (mg *CloudGcpIntegrations) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromIntPtrValue(mg.Spec.ForProvider.LinkedAccountID),
+		Reference:    mg.Spec.ForProvider.LinkedAccountRef,
+		Selector:     mg.Spec.ForProvider.LinkedAccountSelector,
+		To:           reference.To{Managed: &CloudGcpLinkAccount{}, List: &CloudGcpLinkAccountList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Spec.ForProvider.LinkedAccountID")
+	}
+
+	mg.Spec.ForProvider.LinkedAccountID = reference.ToIntPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LinkedAccountRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CloudAzureIntegrations
+func (mg *CloudAzureIntegrations) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromIntPtrValue(mg.Spec.ForProvider.LinkedAccountID),
+		Reference:    mg.Spec.ForProvider.LinkedAccountRef,
+		Selector:     mg.Spec.ForProvider.LinkedAccountSelector,
+		To:           reference.To{Managed: &CloudAzureLinkAccount{}, List: &CloudAzureLinkAccountList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Spec.ForProvider.LinkedAccountID")
+	}
+
+	mg.Spec.ForProvider.LinkedAccountID = reference.ToIntPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LinkedAccountRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveObjectField reads the string value a ObjectFieldReference points at
+func ResolveObjectField(ctx context.Context, c client.Reader, ref *ObjectFieldReference) (string, error) {
+	gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+	if !referenceableKinds[gvk.GroupKind()] {
+		return "", errors.Errorf("cannot reference %s: only IAM Roles of provider-aws can be referenced", gvk.GroupKind())
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, u); err != nil {
+		return "", errors.Wrapf(err, "cannot get %s %s", ref.Kind, ref.Name)
+	}
+
+	path := ref.FieldPath
+	if path == "" {
+		path = defaultFieldPath
+	}
+	value, err := fieldpath.Pave(u.Object).GetString(path)
+	if err != nil && !fieldpath.IsNotFound(err) {
+		return "", err
+	}
+	if value == "" {
+		return "", errors.Errorf("%s of %s %s not yet resolvable", path, ref.Kind, ref.Name)
+	}
+	return value, nil
+}
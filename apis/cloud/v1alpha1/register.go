@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "cloud.provider-newrelic.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// CloudAwsLinkAccount type metadata.
+var (
+	CloudAwsLinkAccountKind             = reflect.TypeOf(CloudAwsLinkAccount{}).Name()
+	CloudAwsLinkAccountGroupKind        = schema.GroupKind{Group: Group, Kind: CloudAwsLinkAccountKind}.String()
+	CloudAwsLinkAccountKindAPIVersion   = CloudAwsLinkAccountKind + "." + SchemeGroupVersion.String()
+	CloudAwsLinkAccountGroupVersionKind = SchemeGroupVersion.WithKind(CloudAwsLinkAccountKind)
+)
+
+// CloudAwsIntegrations type metadata.
+var (
+	CloudAwsIntegrationsKind             = reflect.TypeOf(CloudAwsIntegrations{}).Name()
+	CloudAwsIntegrationsGroupKind        = schema.GroupKind{Group: Group, Kind: CloudAwsIntegrationsKind}.String()
+	CloudAwsIntegrationsKindAPIVersion   = CloudAwsIntegrationsKind + "." + SchemeGroupVersion.String()
+	CloudAwsIntegrationsGroupVersionKind = SchemeGroupVersion.WithKind(CloudAwsIntegrationsKind)
+)
+
+// CloudGcpLinkAccount type metadata.
+var (
+	CloudGcpLinkAccountKind             = reflect.TypeOf(CloudGcpLinkAccount{}).Name()
+	CloudGcpLinkAccountGroupKind        = schema.GroupKind{Group: Group, Kind: CloudGcpLinkAccountKind}.String()
+	CloudGcpLinkAccountKindAPIVersion   = CloudGcpLinkAccountKind + "." + SchemeGroupVersion.String()
+	CloudGcpLinkAccountGroupVersionKind = SchemeGroupVersion.WithKind(CloudGcpLinkAccountKind)
+)
+
+// CloudGcpIntegrations type metadata.
+var (
+	CloudGcpIntegrationsKind             = reflect.TypeOf(CloudGcpIntegrations{}).Name()
+	CloudGcpIntegrationsGroupKind        = schema.GroupKind{Group: Group, Kind: CloudGcpIntegrationsKind}.String()
+	CloudGcpIntegrationsKindAPIVersion   = CloudGcpIntegrationsKind + "." + SchemeGroupVersion.String()
+	CloudGcpIntegrationsGroupVersionKind = SchemeGroupVersion.WithKind(CloudGcpIntegrationsKind)
+)
+
+// CloudAzureLinkAccount type metadata.
+var (
+	CloudAzureLinkAccountKind             = reflect.TypeOf(CloudAzureLinkAccount{}).Name()
+	CloudAzureLinkAccountGroupKind        = schema.GroupKind{Group: Group, Kind: CloudAzureLinkAccountKind}.String()
+	CloudAzureLinkAccountKindAPIVersion   = CloudAzureLinkAccountKind + "." + SchemeGroupVersion.String()
+	CloudAzureLinkAccountGroupVersionKind = SchemeGroupVersion.WithKind(CloudAzureLinkAccountKind)
+)
+
+// CloudAzureIntegrations type metadata.
+var (
+	CloudAzureIntegrationsKind             = reflect.TypeOf(CloudAzureIntegrations{}).Name()
+	CloudAzureIntegrationsGroupKind        = schema.GroupKind{Group: Group, Kind: CloudAzureIntegrationsKind}.String()
+	CloudAzureIntegrationsKindAPIVersion   = CloudAzureIntegrationsKind + "." + SchemeGroupVersion.String()
+	CloudAzureIntegrationsGroupVersionKind = SchemeGroupVersion.WithKind(CloudAzureIntegrationsKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudAwsLinkAccount{}, &CloudAwsLinkAccountList{})
+	SchemeBuilder.Register(&CloudAwsIntegrations{}, &CloudAwsIntegrationsList{})
+	SchemeBuilder.Register(&CloudGcpLinkAccount{}, &CloudGcpLinkAccountList{})
+	SchemeBuilder.Register(&CloudGcpIntegrations{}, &CloudGcpIntegrationsList{})
+	SchemeBuilder.Register(&CloudAzureLinkAccount{}, &CloudAzureLinkAccountList{})
+	SchemeBuilder.Register(&CloudAzureIntegrations{}, &CloudAzureIntegrationsList{})
+}
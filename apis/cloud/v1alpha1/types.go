@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// An ObjectFieldReference points at a field of another Kubernetes object.
+// Only the IAM Roles of provider-aws can be referenced, since the provider is
+// only granted permission to read those.
+// +kubebuilder:validation:XValidation:rule="self.kind == 'Role' && (self.apiVersion.startsWith('iam.aws.upbound.io/') || self.apiVersion.startsWith('iam.aws.crossplane.io/'))",message="only IAM Roles of provider-aws can be referenced"
+type ObjectFieldReference struct {
+	// APIVersion of the referenced object, e.g. iam.aws.upbound.io/v1beta1.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the referenced object, e.g. Role.
+	Kind string `json:"kind"`
+	// Name of the referenced object.
+	Name string `json:"name"`
+	// Namespace of the referenced object, if it is namespaced.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// FieldPath of the value in the referenced object.
+	// +kubebuilder:default="status.atProvider.arn"
+	// +optional
+	FieldPath string `json:"fieldPath,omitempty"`
+}
+
+// CloudIntegrationObservation is an integration enabled on a linked account.
+type CloudIntegrationObservation struct {
+	// Service is the NerdGraph name of the integration.
+	Service string `json:"service"`
+	// The integration identifier in New Relic.
+	ID int `json:"id,omitempty"`
+}
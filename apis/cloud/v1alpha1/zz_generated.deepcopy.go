@@ -0,0 +1,1026 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsIntegration) DeepCopyInto(out *AwsIntegration) {
+	*out = *in
+	if in.MetricsPollingInterval != nil {
+		in, out := &in.MetricsPollingInterval, &out.MetricsPollingInterval
+		*out = new(int)
+		**out = **in
+	}
+	if in.AwsRegions != nil {
+		in, out := &in.AwsRegions, &out.AwsRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FetchTags != nil {
+		in, out := &in.FetchTags, &out.FetchTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FetchExtendedInventory != nil {
+		in, out := &in.FetchExtendedInventory, &out.FetchExtendedInventory
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TagKey != nil {
+		in, out := &in.TagKey, &out.TagKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagValue != nil {
+		in, out := &in.TagValue, &out.TagValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsIntegration.
+func (in *AwsIntegration) DeepCopy() *AwsIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureIntegration) DeepCopyInto(out *AzureIntegration) {
+	*out = *in
+	if in.MetricsPollingInterval != nil {
+		in, out := &in.MetricsPollingInterval, &out.MetricsPollingInterval
+		*out = new(int)
+		**out = **in
+	}
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludeTags != nil {
+		in, out := &in.IncludeTags, &out.IncludeTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeTags != nil {
+		in, out := &in.ExcludeTags, &out.ExcludeTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceTypes != nil {
+		in, out := &in.ResourceTypes, &out.ResourceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureIntegration.
+func (in *AzureIntegration) DeepCopy() *AzureIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsIntegrations) DeepCopyInto(out *CloudAwsIntegrations) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsIntegrations.
+func (in *CloudAwsIntegrations) DeepCopy() *CloudAwsIntegrations {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsIntegrations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudAwsIntegrations) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsIntegrationsList) DeepCopyInto(out *CloudAwsIntegrationsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudAwsIntegrations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsIntegrationsList.
+func (in *CloudAwsIntegrationsList) DeepCopy() *CloudAwsIntegrationsList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsIntegrationsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudAwsIntegrationsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsIntegrationsObservation) DeepCopyInto(out *CloudAwsIntegrationsObservation) {
+	*out = *in
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]CloudIntegrationObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsIntegrationsObservation.
+func (in *CloudAwsIntegrationsObservation) DeepCopy() *CloudAwsIntegrationsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsIntegrationsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsIntegrationsParameters) DeepCopyInto(out *CloudAwsIntegrationsParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
+	if in.LinkedAccountID != nil {
+		in, out := &in.LinkedAccountID, &out.LinkedAccountID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LinkedAccountRef != nil {
+		in, out := &in.LinkedAccountRef, &out.LinkedAccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinkedAccountSelector != nil {
+		in, out := &in.LinkedAccountSelector, &out.LinkedAccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]AwsIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsIntegrationsParameters.
+func (in *CloudAwsIntegrationsParameters) DeepCopy() *CloudAwsIntegrationsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsIntegrationsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsIntegrationsSpec) DeepCopyInto(out *CloudAwsIntegrationsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsIntegrationsSpec.
+func (in *CloudAwsIntegrationsSpec) DeepCopy() *CloudAwsIntegrationsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsIntegrationsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsIntegrationsStatus) DeepCopyInto(out *CloudAwsIntegrationsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsIntegrationsStatus.
+func (in *CloudAwsIntegrationsStatus) DeepCopy() *CloudAwsIntegrationsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsIntegrationsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsLinkAccount) DeepCopyInto(out *CloudAwsLinkAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsLinkAccount.
+func (in *CloudAwsLinkAccount) DeepCopy() *CloudAwsLinkAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsLinkAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudAwsLinkAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsLinkAccountList) DeepCopyInto(out *CloudAwsLinkAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudAwsLinkAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsLinkAccountList.
+func (in *CloudAwsLinkAccountList) DeepCopy() *CloudAwsLinkAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsLinkAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudAwsLinkAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsLinkAccountObservation) DeepCopyInto(out *CloudAwsLinkAccountObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsLinkAccountObservation.
+func (in *CloudAwsLinkAccountObservation) DeepCopy() *CloudAwsLinkAccountObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsLinkAccountObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsLinkAccountParameters) DeepCopyInto(out *CloudAwsLinkAccountParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ArnRef != nil {
+		in, out := &in.ArnRef, &out.ArnRef
+		*out = new(ObjectFieldReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsLinkAccountParameters.
+func (in *CloudAwsLinkAccountParameters) DeepCopy() *CloudAwsLinkAccountParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsLinkAccountParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsLinkAccountSpec) DeepCopyInto(out *CloudAwsLinkAccountSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsLinkAccountSpec.
+func (in *CloudAwsLinkAccountSpec) DeepCopy() *CloudAwsLinkAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsLinkAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAwsLinkAccountStatus) DeepCopyInto(out *CloudAwsLinkAccountStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAwsLinkAccountStatus.
+func (in *CloudAwsLinkAccountStatus) DeepCopy() *CloudAwsLinkAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAwsLinkAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureIntegrations) DeepCopyInto(out *CloudAzureIntegrations) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureIntegrations.
+func (in *CloudAzureIntegrations) DeepCopy() *CloudAzureIntegrations {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureIntegrations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudAzureIntegrations) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureIntegrationsList) DeepCopyInto(out *CloudAzureIntegrationsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudAzureIntegrations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureIntegrationsList.
+func (in *CloudAzureIntegrationsList) DeepCopy() *CloudAzureIntegrationsList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureIntegrationsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudAzureIntegrationsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureIntegrationsObservation) DeepCopyInto(out *CloudAzureIntegrationsObservation) {
+	*out = *in
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]CloudIntegrationObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureIntegrationsObservation.
+func (in *CloudAzureIntegrationsObservation) DeepCopy() *CloudAzureIntegrationsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureIntegrationsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureIntegrationsParameters) DeepCopyInto(out *CloudAzureIntegrationsParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
+	if in.LinkedAccountID != nil {
+		in, out := &in.LinkedAccountID, &out.LinkedAccountID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LinkedAccountRef != nil {
+		in, out := &in.LinkedAccountRef, &out.LinkedAccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinkedAccountSelector != nil {
+		in, out := &in.LinkedAccountSelector, &out.LinkedAccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]AzureIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureIntegrationsParameters.
+func (in *CloudAzureIntegrationsParameters) DeepCopy() *CloudAzureIntegrationsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureIntegrationsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureIntegrationsSpec) DeepCopyInto(out *CloudAzureIntegrationsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureIntegrationsSpec.
+func (in *CloudAzureIntegrationsSpec) DeepCopy() *CloudAzureIntegrationsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureIntegrationsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureIntegrationsStatus) DeepCopyInto(out *CloudAzureIntegrationsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureIntegrationsStatus.
+func (in *CloudAzureIntegrationsStatus) DeepCopy() *CloudAzureIntegrationsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureIntegrationsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureLinkAccount) DeepCopyInto(out *CloudAzureLinkAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureLinkAccount.
+func (in *CloudAzureLinkAccount) DeepCopy() *CloudAzureLinkAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureLinkAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudAzureLinkAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureLinkAccountList) DeepCopyInto(out *CloudAzureLinkAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudAzureLinkAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureLinkAccountList.
+func (in *CloudAzureLinkAccountList) DeepCopy() *CloudAzureLinkAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureLinkAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudAzureLinkAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureLinkAccountObservation) DeepCopyInto(out *CloudAzureLinkAccountObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureLinkAccountObservation.
+func (in *CloudAzureLinkAccountObservation) DeepCopy() *CloudAzureLinkAccountObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureLinkAccountObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureLinkAccountParameters) DeepCopyInto(out *CloudAzureLinkAccountParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
+	out.ClientSecretRef = in.ClientSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureLinkAccountParameters.
+func (in *CloudAzureLinkAccountParameters) DeepCopy() *CloudAzureLinkAccountParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureLinkAccountParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureLinkAccountSpec) DeepCopyInto(out *CloudAzureLinkAccountSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureLinkAccountSpec.
+func (in *CloudAzureLinkAccountSpec) DeepCopy() *CloudAzureLinkAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureLinkAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAzureLinkAccountStatus) DeepCopyInto(out *CloudAzureLinkAccountStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAzureLinkAccountStatus.
+func (in *CloudAzureLinkAccountStatus) DeepCopy() *CloudAzureLinkAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAzureLinkAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpIntegrations) DeepCopyInto(out *CloudGcpIntegrations) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpIntegrations.
+func (in *CloudGcpIntegrations) DeepCopy() *CloudGcpIntegrations {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpIntegrations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudGcpIntegrations) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpIntegrationsList) DeepCopyInto(out *CloudGcpIntegrationsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudGcpIntegrations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpIntegrationsList.
+func (in *CloudGcpIntegrationsList) DeepCopy() *CloudGcpIntegrationsList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpIntegrationsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudGcpIntegrationsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpIntegrationsObservation) DeepCopyInto(out *CloudGcpIntegrationsObservation) {
+	*out = *in
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]CloudIntegrationObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpIntegrationsObservation.
+func (in *CloudGcpIntegrationsObservation) DeepCopy() *CloudGcpIntegrationsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpIntegrationsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpIntegrationsParameters) DeepCopyInto(out *CloudGcpIntegrationsParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
+	if in.LinkedAccountID != nil {
+		in, out := &in.LinkedAccountID, &out.LinkedAccountID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LinkedAccountRef != nil {
+		in, out := &in.LinkedAccountRef, &out.LinkedAccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinkedAccountSelector != nil {
+		in, out := &in.LinkedAccountSelector, &out.LinkedAccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]GcpIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpIntegrationsParameters.
+func (in *CloudGcpIntegrationsParameters) DeepCopy() *CloudGcpIntegrationsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpIntegrationsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpIntegrationsSpec) DeepCopyInto(out *CloudGcpIntegrationsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpIntegrationsSpec.
+func (in *CloudGcpIntegrationsSpec) DeepCopy() *CloudGcpIntegrationsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpIntegrationsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpIntegrationsStatus) DeepCopyInto(out *CloudGcpIntegrationsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpIntegrationsStatus.
+func (in *CloudGcpIntegrationsStatus) DeepCopy() *CloudGcpIntegrationsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpIntegrationsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpLinkAccount) DeepCopyInto(out *CloudGcpLinkAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpLinkAccount.
+func (in *CloudGcpLinkAccount) DeepCopy() *CloudGcpLinkAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpLinkAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudGcpLinkAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpLinkAccountList) DeepCopyInto(out *CloudGcpLinkAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudGcpLinkAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpLinkAccountList.
+func (in *CloudGcpLinkAccountList) DeepCopy() *CloudGcpLinkAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpLinkAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudGcpLinkAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpLinkAccountObservation) DeepCopyInto(out *CloudGcpLinkAccountObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpLinkAccountObservation.
+func (in *CloudGcpLinkAccountObservation) DeepCopy() *CloudGcpLinkAccountObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpLinkAccountObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpLinkAccountParameters) DeepCopyInto(out *CloudGcpLinkAccountParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpLinkAccountParameters.
+func (in *CloudGcpLinkAccountParameters) DeepCopy() *CloudGcpLinkAccountParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpLinkAccountParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpLinkAccountSpec) DeepCopyInto(out *CloudGcpLinkAccountSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpLinkAccountSpec.
+func (in *CloudGcpLinkAccountSpec) DeepCopy() *CloudGcpLinkAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpLinkAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudGcpLinkAccountStatus) DeepCopyInto(out *CloudGcpLinkAccountStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudGcpLinkAccountStatus.
+func (in *CloudGcpLinkAccountStatus) DeepCopy() *CloudGcpLinkAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudGcpLinkAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudIntegrationObservation) DeepCopyInto(out *CloudIntegrationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudIntegrationObservation.
+func (in *CloudIntegrationObservation) DeepCopy() *CloudIntegrationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudIntegrationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpIntegration) DeepCopyInto(out *GcpIntegration) {
+	*out = *in
+	if in.MetricsPollingInterval != nil {
+		in, out := &in.MetricsPollingInterval, &out.MetricsPollingInterval
+		*out = new(int)
+		**out = **in
+	}
+	if in.FetchTags != nil {
+		in, out := &in.FetchTags, &out.FetchTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FetchTableMetrics != nil {
+		in, out := &in.FetchTableMetrics, &out.FetchTableMetrics
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpIntegration.
+func (in *GcpIntegration) DeepCopy() *GcpIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectFieldReference) DeepCopyInto(out *ObjectFieldReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectFieldReference.
+func (in *ObjectFieldReference) DeepCopy() *ObjectFieldReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectFieldReference)
+	in.DeepCopyInto(out)
+	return out
+}
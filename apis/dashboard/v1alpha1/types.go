@@ -25,12 +25,18 @@ import (
 
 // DashboardParameters are the configurable fields of a Policy.
 type DashboardParameters struct {
-	// Account ID.
-	AccountID int `json:"accountId,omitempty"`
+	// ID of the account the dashboard belongs to. Defaults to the account of
+	// the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
 
 	// Dashboard description.
 	Description *string `json:"description,omitempty"`
 	// Unique entity identifier.
+	// Deprecated: the GUID is kept in the external name annotation and
+	// reported in status.atProvider.guid. It is only read from objects that
+	// have no external name yet.
 	GUID string `json:"guid,omitempty"`
 	// Dashboard name.
 	Name string `json:"name,omitempty"`
@@ -50,7 +56,15 @@ type DashboardPage struct {
 	// Page description.
 	Description *string `json:"description,omitempty"`
 	// Unique entity identifier.
+	// Deprecated: the GUID is reported in status.atProvider.pages. It is only
+	// read to map the key of the page when the status has no GUID for it.
 	GUID string `json:"guid,omitempty"`
+	// Key identifies the page across updates, so that renaming it edits the
+	// page in place rather than replacing it. Defaults to the page name and
+	// should be unique within the dashboard. A page renamed without a key
+	// keeps its previous key if it stays at the same position.
+	// +optional
+	Key string `json:"key,omitempty"`
 	// Page name.
 	Name string `json:"name,omitempty"`
 	// Page widgets.
@@ -60,7 +74,15 @@ type DashboardPage struct {
 // DashboardWidget - Widgets in a Dashboard Page.
 type DashboardWidget struct {
 	// id
+	// Deprecated: the ID is reported in status.atProvider.pages. It is only
+	// read to map the key of the widget when the status has no ID for it.
 	ID *string `json:"id,omitempty"`
+	// Key identifies the widget across updates, so that renaming or moving it
+	// edits the widget in place rather than replacing it. Defaults to the
+	// widget title and should be unique within the page. A widget retitled
+	// without a key keeps its previous key if it stays at the same position.
+	// +optional
+	Key string `json:"key,omitempty"`
 	// layout
 	Layout DashboardWidgetLayout `json:"layout,omitempty"`
 	// Untyped configuration
@@ -187,6 +209,32 @@ type DashboardObservation struct {
 	// The stable and unique string guid from NewRelic.
 	GUID            string `json:"guid,omitempty"`
 	ObservableField string `json:"observableField,omitempty"`
+
+	// Pages map the keys of the pages and their widgets to the identifiers
+	// New Relic assigned them.
+	Pages []DashboardPageObservation `json:"pages,omitempty"`
+
+	// DriftedFields lists the fields that differed from the spec when the
+	// dashboard was last observed.
+	DriftedFields string `json:"driftedFields,omitempty"`
+}
+
+// DashboardPageObservation is the identity of a page in New Relic.
+type DashboardPageObservation struct {
+	// Key of the page.
+	Key string `json:"key"`
+	// The unique entity identifier of the page.
+	GUID string `json:"guid"`
+	// Widgets of the page.
+	Widgets []DashboardWidgetObservation `json:"widgets,omitempty"`
+}
+
+// DashboardWidgetObservation is the identity of a widget in New Relic.
+type DashboardWidgetObservation struct {
+	// Key of the widget.
+	Key string `json:"key"`
+	// The identifier of the widget.
+	ID string `json:"id"`
 }
 
 // A DashboardSpec defines the desired state of a Policy.
@@ -218,18 +266,6 @@ type Dashboard struct {
 	Status DashboardStatus `json:"status,omitempty"`
 }
 
-// SetPublishConnectionDetailsTo is a func for connection details
-func (in *Dashboard) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
-	// TODO implement me
-	panic("implement me")
-}
-
-// GetPublishConnectionDetailsTo is a func for connection details
-func (in *Dashboard) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
-	// TODO implement me
-	panic("implement me")
-}
-
 // +kubebuilder:object:root=true
 
 // DashboardList contains a list of Dashboard
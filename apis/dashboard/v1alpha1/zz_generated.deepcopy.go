@@ -111,6 +111,13 @@ func (in *DashboardList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardObservation) DeepCopyInto(out *DashboardObservation) {
 	*out = *in
+	if in.Pages != nil {
+		in, out := &in.Pages, &out.Pages
+		*out = make([]DashboardPageObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardObservation.
@@ -150,9 +157,34 @@ func (in *DashboardPage) DeepCopy() *DashboardPage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardPageObservation) DeepCopyInto(out *DashboardPageObservation) {
+	*out = *in
+	if in.Widgets != nil {
+		in, out := &in.Widgets, &out.Widgets
+		*out = make([]DashboardWidgetObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardPageObservation.
+func (in *DashboardPageObservation) DeepCopy() *DashboardPageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardPageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardParameters) DeepCopyInto(out *DashboardParameters) {
 	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -210,7 +242,7 @@ func (in *DashboardSpec) DeepCopy() *DashboardSpec {
 func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardStatus.
@@ -380,6 +412,21 @@ func (in *DashboardWidgetNRQLQueryInput) DeepCopy() *DashboardWidgetNRQLQueryInp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidgetObservation) DeepCopyInto(out *DashboardWidgetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidgetObservation.
+func (in *DashboardWidgetObservation) DeepCopy() *DashboardWidgetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidgetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardWidgetRawConfiguration) DeepCopyInto(out *DashboardWidgetRawConfiguration) {
 	*out = *in
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-entities-api-tutorial/
+
+// EntityLookupParameters are the configurable fields of an EntityLookup.
+// All filters that are set must match.
+// +kubebuilder:validation:XValidation:rule="has(self.name) || has(self.domain) || has(self.type) || has(self.tags) || has(self.query)",message="at least one filter must be set"
+type EntityLookupParameters struct {
+	// Name of the entity, matched exactly.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Domain of the entity, e.g. APM, BROWSER or INFRA.
+	// +optional
+	Domain *string `json:"domain,omitempty"`
+
+	// Type of the entity, e.g. APPLICATION or HOST.
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Tags the entity must have.
+	// +optional
+	Tags []EntityTag `json:"tags,omitempty"`
+
+	// ID of the account holding the entity. It must be allowed by the ProviderConfig.
+	// +optional
+	AccountID *int `json:"accountId,omitempty"`
+
+	// Query is an additional entity search query, e.g. "reporting = 'true'".
+	// +optional
+	Query *string `json:"query,omitempty"`
+}
+
+// EntityTag is a tag an entity must have.
+type EntityTag struct {
+	// The tag key.
+	Key string `json:"key"`
+	// The tag value.
+	Value string `json:"value"`
+}
+
+// EntityLookupObservation are the observable fields of an EntityLookup.
+type EntityLookupObservation struct {
+	// GUID of the first matching entity.
+	GUID string `json:"guid,omitempty"`
+	// GUIDs of all matching entities, up to 100.
+	GUIDs []string `json:"guids,omitempty"`
+	// Number of matching entities.
+	Count int `json:"count,omitempty"`
+}
+
+// An EntityLookupSpec defines the desired state of an EntityLookup.
+type EntityLookupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EntityLookupParameters `json:"forProvider"`
+}
+
+// An EntityLookupStatus represents the observed state of an EntityLookup.
+type EntityLookupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EntityLookupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EntityLookup searches for entities on each poll and exposes their GUIDs, so other
+// resources can reference entities by name, type and tags. Nothing is created in New Relic.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="GUID",type="string",JSONPath=".status.atProvider.guid"
+// +kubebuilder:printcolumn:name="COUNT",type="integer",JSONPath=".status.atProvider.count"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type EntityLookup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EntityLookupSpec   `json:"spec"`
+	Status EntityLookupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EntityLookupList contains a list of EntityLookup
+type EntityLookupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EntityLookup `json:"items"`
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	entitylookup "github.com/crossplane-contrib/provider-newrelic/apis/entitylookup/v1alpha1"
+)
+
+// ResolveReferences of this KeyTransaction
+func (mg *KeyTransaction) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ApplicationGUID,
+		Reference:    mg.Spec.ForProvider.ApplicationGUIDRef,
+		Selector:     mg.Spec.ForProvider.ApplicationGUIDSelector,
+		To:           reference.To{Managed: &entitylookup.EntityLookup{}, List: &entitylookup.EntityLookupList{}},
+		Extract:      EntityGUID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Spec.ForProvider.ApplicationGUID")
+	}
+
+	mg.Spec.ForProvider.ApplicationGUID = rsp.ResolvedValue
+	mg.Spec.ForProvider.ApplicationGUIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// EntityGUID extracts the GUID found by a referenced EntityLookup
+func EntityGUID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*entitylookup.EntityLookup)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.GUID
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-key-transactions/
+
+// KeyTransactionParameters are the configurable fields of a KeyTransaction.
+type KeyTransactionParameters struct {
+	// ID of the account the application belongs to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// Name of the key transaction.
+	Name string `json:"name"`
+
+	// GUID of the APM application the key transaction belongs to. It can be
+	// set once, e.g. when resolved from a reference, but not changed.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="applicationGuid is immutable"
+	ApplicationGUID string `json:"applicationGuid,omitempty"`
+
+	// ApplicationGUIDRef is a reference to an EntityLookup used to set
+	// the ApplicationGUID.
+	// +optional
+	ApplicationGUIDRef *xpv1.Reference `json:"applicationGuidRef,omitempty"`
+
+	// ApplicationGUIDSelector selects references to an EntityLookup used
+	// to set the ApplicationGUID.
+	// +optional
+	ApplicationGUIDSelector *xpv1.Selector `json:"applicationGuidSelector,omitempty"`
+
+	// Name of the APM application the key transaction belongs to.
+	// Used to look up the application GUID when applicationGuid is not set.
+	// It is immutable.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="applicationName is immutable"
+	ApplicationName *string `json:"applicationName,omitempty"`
+
+	// Name of the metric underlying the key transaction,
+	// e.g. WebTransaction/Controller/orders/create. It is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="metricName is immutable"
+	MetricName string `json:"metricName"`
+
+	// APM Apdex target, in seconds.
+	ApdexTarget float64 `json:"apdexTarget"`
+
+	// Browser Apdex target, in seconds.
+	BrowserApdexTarget float64 `json:"browserApdexTarget"`
+}
+
+// KeyTransactionObservation are the observable fields of a KeyTransaction.
+type KeyTransactionObservation struct {
+	// The stable and unique string guid from NewRelic.
+	GUID string `json:"guid,omitempty"`
+	// GUID of the APM application the key transaction belongs to.
+	ApplicationGUID string `json:"applicationGuid,omitempty"`
+}
+
+// A KeyTransactionSpec defines the desired state of a KeyTransaction.
+type KeyTransactionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyTransactionParameters `json:"forProvider"`
+}
+
+// A KeyTransactionStatus represents the observed state of a KeyTransaction.
+type KeyTransactionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyTransactionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyTransaction is an APM key transaction with its own Apdex targets.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="GUID",type="string",JSONPath=".status.atProvider.guid"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type KeyTransaction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyTransactionSpec   `json:"spec"`
+	Status KeyTransactionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyTransactionList contains a list of KeyTransaction
+type KeyTransactionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyTransaction `json:"items"`
+}
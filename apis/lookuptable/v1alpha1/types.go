@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/logs/ui-data/lookup-tables-ui/
+
+// LookupTableParameters are the configurable fields of a LookupTable.
+type LookupTableParameters struct {
+	// ID of the account the table belongs to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// Name of the table, as used in NRQL: FROM lookup(<name>).
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tableName is immutable"
+	TableName string `json:"tableName"`
+
+	// ConfigMapRef selects the ConfigMap key holding the table as CSV,
+	// with the column names on the first line.
+	ConfigMapRef ConfigMapKeySelector `json:"configMapRef"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// LookupTableObservation are the observable fields of a LookupTable.
+type LookupTableObservation struct {
+	// Number of rows uploaded, excluding the header.
+	RowCount int `json:"rowCount,omitempty"`
+	// SHA-256 of the uploaded CSV.
+	ContentHash string `json:"contentHash,omitempty"`
+	// Time of the last upload.
+	LastUploadTime *metav1.Time `json:"lastUploadTime,omitempty"`
+}
+
+// A LookupTableSpec defines the desired state of a LookupTable.
+type LookupTableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LookupTableParameters `json:"forProvider"`
+}
+
+// A LookupTableStatus represents the observed state of a LookupTable.
+type LookupTableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LookupTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LookupTable is a CSV table NRQL queries can join against.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ROWS",type="integer",JSONPath=".status.atProvider.rowCount"
+// +kubebuilder:printcolumn:name="UPLOADED",type="date",JSONPath=".status.atProvider.lastUploadTime"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type LookupTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LookupTableSpec   `json:"spec"`
+	Status LookupTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LookupTableList contains a list of LookupTable
+type LookupTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LookupTable `json:"items"`
+}
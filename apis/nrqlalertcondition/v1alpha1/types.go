@@ -25,6 +25,12 @@ import (
 
 // NrqlAlertConditionParameters are the configurable fields of a Condition
 type NrqlAlertConditionParameters struct {
+	// ID of the account the condition belongs to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
 	ID string `json:"id,omitempty"`
 	// +kubebuilder:validation:Enum=STATIC;BASELINE;OUTLIER
 	Type       string  `json:"type,omitempty"`
@@ -106,6 +112,10 @@ type NrqlAlertConditionObservation struct {
 	// The stable and unique string id from NewRelic.
 	ID              string `json:"id,omitempty"`
 	ObservableField string `json:"observableField,omitempty"`
+
+	// DriftedFields lists the fields that differed from the spec when the
+	// condition was last observed.
+	DriftedFields string `json:"driftedFields,omitempty"`
 }
 
 // A NrqlAlertConditionSpec defines the desired state of a Condition.
@@ -137,6 +137,11 @@ func (in *NrqlAlertConditionObservation) DeepCopy() *NrqlAlertConditionObservati
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NrqlAlertConditionParameters) DeepCopyInto(out *NrqlAlertConditionParameters) {
 	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(int)
+		**out = **in
+	}
 	if in.RunbookURL != nil {
 		in, out := &in.RunbookURL, &out.RunbookURL
 		*out = new(string)
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-nrql-tutorial/
+
+// NrqlQueryParameters are the configurable fields of a NrqlQuery.
+type NrqlQueryParameters struct {
+	// The NRQL query to run on each poll.
+	Query string `json:"query"`
+
+	// ID of the account to query. Defaults to the account of the ProviderConfig
+	// and must be allowed by it.
+	// +optional
+	AccountID *int `json:"accountId,omitempty"`
+
+	// Fields of the result rows to keep. All fields are kept when empty.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+
+	// Maximum number of result rows to keep.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=10
+	// +optional
+	MaxRows *int `json:"maxRows,omitempty"`
+}
+
+// NrqlQueryObservation are the observable fields of a NrqlQuery.
+type NrqlQueryObservation struct {
+	// Results of the last successful run, as field to value. Values that are
+	// not strings are JSON encoded.
+	Results []map[string]string `json:"results,omitempty"`
+	// Number of rows returned by the query, before MaxRows is applied.
+	RowCount int `json:"rowCount,omitempty"`
+	// Time of the last run.
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+	// Error of the last run, empty if it succeeded.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// A NrqlQuerySpec defines the desired state of a NrqlQuery.
+type NrqlQuerySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NrqlQueryParameters `json:"forProvider"`
+}
+
+// A NrqlQueryStatus represents the observed state of a NrqlQuery.
+type NrqlQueryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NrqlQueryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NrqlQuery runs a NRQL query on each poll and exposes the results, as a data source
+// for other resources. Nothing is created in New Relic. The fields of the first row are
+// published as connection details, and all rows as JSON under the results key.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ROWS",type="integer",JSONPath=".status.atProvider.rowCount"
+// +kubebuilder:printcolumn:name="LAST-RUN",type="date",JSONPath=".status.atProvider.lastRunTime"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type NrqlQuery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NrqlQuerySpec   `json:"spec"`
+	Status NrqlQueryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NrqlQueryList contains a list of NrqlQuery
+type NrqlQueryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NrqlQuery `json:"items"`
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-streaming-export/
+
+// StreamingExportRuleParameters are the configurable fields of a StreamingExportRule.
+type StreamingExportRuleParameters struct {
+	// ID of the account the rule belongs to. Defaults to the
+	// account of the ProviderConfig and must be allowed by it.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountId is immutable"
+	AccountID *int `json:"accountId,omitempty"`
+
+	// Name of the rule.
+	Name string `json:"name"`
+
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// NRQL query selecting the data to export, e.g. SELECT * FROM Transaction.
+	// Only SELECT ... FROM ... WHERE ... queries without aggregation are supported.
+	Nrql string `json:"nrql"`
+
+	// Whether the rule is exporting data. Disabling a rule keeps its definition.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Destination the data is exported to.
+	Destination StreamingExportDestination `json:"destination"`
+}
+
+// StreamingExportDestination is where a StreamingExportRule sends its data.
+// Only the block matching the type is used.
+// +kubebuilder:validation:XValidation:rule="self.type != 'KINESIS_FIREHOSE' || has(self.kinesisFirehose)",message="kinesisFirehose must be set"
+// +kubebuilder:validation:XValidation:rule="self.type != 'EVENT_HUB' || has(self.eventHub)",message="eventHub must be set"
+// +kubebuilder:validation:XValidation:rule="self.type != 'PUB_SUB' || has(self.pubSub)",message="pubSub must be set"
+type StreamingExportDestination struct {
+	// Type of the destination.
+	// +kubebuilder:validation:Enum=KINESIS_FIREHOSE;EVENT_HUB;PUB_SUB
+	Type string `json:"type"`
+
+	// An AWS Kinesis Data Firehose delivery stream.
+	// +optional
+	KinesisFirehose *KinesisFirehoseDestination `json:"kinesisFirehose,omitempty"`
+
+	// An Azure Event Hub.
+	// +optional
+	EventHub *EventHubDestination `json:"eventHub,omitempty"`
+
+	// A GCP Pub/Sub topic.
+	// +optional
+	PubSub *PubSubDestination `json:"pubSub,omitempty"`
+}
+
+// KinesisFirehoseDestination is an AWS Kinesis Data Firehose delivery stream.
+type KinesisFirehoseDestination struct {
+	// The AWS account ID the delivery stream belongs to.
+	AwsAccountID string `json:"awsAccountId"`
+
+	// Name of the delivery stream.
+	DeliveryStreamName string `json:"deliveryStreamName"`
+
+	// AWS region of the delivery stream.
+	Region string `json:"region"`
+
+	// Name of the IAM role New Relic assumes to write to the delivery stream.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// RoleSecretRef reads the role from a Secret instead, e.g. when the
+	// ARN is published as a connection detail by provider-aws.
+	// +optional
+	RoleSecretRef *xpv1.SecretKeySelector `json:"roleSecretRef,omitempty"`
+}
+
+// EventHubDestination is an Azure Event Hub.
+type EventHubDestination struct {
+	// Name of the Event Hub.
+	EventHubName string `json:"eventHubName"`
+
+	// ConnectionStringSecretRef references the key of a Secret holding the
+	// Event Hub connection string.
+	ConnectionStringSecretRef xpv1.SecretKeySelector `json:"connectionStringSecretRef"`
+}
+
+// PubSubDestination is a GCP Pub/Sub topic.
+type PubSubDestination struct {
+	// The GCP project the topic belongs to.
+	ProjectID string `json:"projectId"`
+
+	// ID of the topic.
+	TopicID string `json:"topicId"`
+}
+
+// StreamingExportRuleObservation are the observable fields of a StreamingExportRule.
+type StreamingExportRuleObservation struct {
+	// The rule identifier.
+	ID string `json:"id,omitempty"`
+	// Status of the rule, e.g. ENABLED or CREATION_IN_PROGRESS.
+	Status string `json:"status,omitempty"`
+	// Additional information about the status.
+	Message string `json:"message,omitempty"`
+}
+
+// A StreamingExportRuleSpec defines the desired state of a StreamingExportRule.
+type StreamingExportRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StreamingExportRuleParameters `json:"forProvider"`
+}
+
+// A StreamingExportRuleStatus represents the observed state of a StreamingExportRule.
+type StreamingExportRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StreamingExportRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StreamingExportRule continuously exports the data matching a NRQL query.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type StreamingExportRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StreamingExportRuleSpec   `json:"spec"`
+	Status StreamingExportRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StreamingExportRuleList contains a list of StreamingExportRule
+type StreamingExportRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StreamingExportRule `json:"items"`
+}
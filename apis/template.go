@@ -20,9 +20,17 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	account "github.com/crossplane-contrib/provider-newrelic/apis/account/v1alpha1"
 	alertspolicy "github.com/crossplane-contrib/provider-newrelic/apis/alertspolicy/v1alpha1"
+	cloud "github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
 	dashboard "github.com/crossplane-contrib/provider-newrelic/apis/dashboard/v1alpha1"
+	entitylookup "github.com/crossplane-contrib/provider-newrelic/apis/entitylookup/v1alpha1"
+	keytransaction "github.com/crossplane-contrib/provider-newrelic/apis/keytransaction/v1alpha1"
+	lookuptable "github.com/crossplane-contrib/provider-newrelic/apis/lookuptable/v1alpha1"
 	nrqlalertcondition "github.com/crossplane-contrib/provider-newrelic/apis/nrqlalertcondition/v1alpha1"
+	nrqlquery "github.com/crossplane-contrib/provider-newrelic/apis/nrqlquery/v1alpha1"
+	streamingexportrule "github.com/crossplane-contrib/provider-newrelic/apis/streamingexportrule/v1alpha1"
+	usermanagement "github.com/crossplane-contrib/provider-newrelic/apis/usermanagement/v1alpha1"
 	templatev1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
 )
 
@@ -33,6 +41,14 @@ func init() {
 		alertspolicy.SchemeBuilder.AddToScheme,
 		nrqlalertcondition.SchemeBuilder.AddToScheme,
 		dashboard.SchemeBuilder.AddToScheme,
+		keytransaction.SchemeBuilder.AddToScheme,
+		cloud.SchemeBuilder.AddToScheme,
+		streamingexportrule.SchemeBuilder.AddToScheme,
+		lookuptable.SchemeBuilder.AddToScheme,
+		usermanagement.SchemeBuilder.AddToScheme,
+		account.SchemeBuilder.AddToScheme,
+		nrqlquery.SchemeBuilder.AddToScheme,
+		entitylookup.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-manage-users/
+
+// GroupMembershipParameters are the configurable fields of a GroupMembership.
+type GroupMembershipParameters struct {
+	// ID of the group.
+	// +optional
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupRef is a reference to a Group used to set the GroupID.
+	// +optional
+	GroupRef *xpv1.Reference `json:"groupRef,omitempty"`
+
+	// GroupSelector selects references to a Group used to set the GroupID.
+	// +optional
+	GroupSelector *xpv1.Selector `json:"groupSelector,omitempty"`
+
+	// ID of the user.
+	// +optional
+	UserID *string `json:"userId,omitempty"`
+
+	// UserRef is a reference to a User used to set the UserID.
+	// +optional
+	UserRef *xpv1.Reference `json:"userRef,omitempty"`
+
+	// UserSelector selects references to a User used to set the UserID.
+	// +optional
+	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
+}
+
+// GroupMembershipObservation are the observable fields of a GroupMembership.
+type GroupMembershipObservation struct {
+	// Name of the group.
+	GroupName string `json:"groupName,omitempty"`
+	// Email of the user.
+	UserEmail string `json:"userEmail,omitempty"`
+}
+
+// A GroupMembershipSpec defines the desired state of a GroupMembership.
+type GroupMembershipSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GroupMembershipParameters `json:"forProvider"`
+}
+
+// A GroupMembershipStatus represents the observed state of a GroupMembership.
+type GroupMembershipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GroupMembershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupMembership adds a User to a Group.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".status.atProvider.groupName"
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".status.atProvider.userEmail"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type GroupMembership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupMembershipSpec   `json:"spec"`
+	Status GroupMembershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupMembershipList contains a list of GroupMembership
+type GroupMembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupMembership `json:"items"`
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata. The group is named CRDGroup so it doesn't clash
+// with the Group kind.
+const (
+	CRDGroup = "usermanagement.provider-newrelic.crossplane.io"
+	Version  = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: CRDGroup, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// User type metadata.
+var (
+	UserKind             = reflect.TypeOf(User{}).Name()
+	UserGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: UserKind}.String()
+	UserKindAPIVersion   = UserKind + "." + SchemeGroupVersion.String()
+	UserGroupVersionKind = SchemeGroupVersion.WithKind(UserKind)
+)
+
+// Group type metadata.
+var (
+	GroupKind             = reflect.TypeOf(Group{}).Name()
+	GroupGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GroupKind}.String()
+	GroupKindAPIVersion   = GroupKind + "." + SchemeGroupVersion.String()
+	GroupGroupVersionKind = SchemeGroupVersion.WithKind(GroupKind)
+)
+
+// GroupMembership type metadata.
+var (
+	GroupMembershipKind             = reflect.TypeOf(GroupMembership{}).Name()
+	GroupMembershipGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GroupMembershipKind}.String()
+	GroupMembershipKindAPIVersion   = GroupMembershipKind + "." + SchemeGroupVersion.String()
+	GroupMembershipGroupVersionKind = SchemeGroupVersion.WithKind(GroupMembershipKind)
+)
+
+// RoleGrant type metadata.
+var (
+	RoleGrantKind             = reflect.TypeOf(RoleGrant{}).Name()
+	RoleGrantGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: RoleGrantKind}.String()
+	RoleGrantKindAPIVersion   = RoleGrantKind + "." + SchemeGroupVersion.String()
+	RoleGrantGroupVersionKind = SchemeGroupVersion.WithKind(RoleGrantKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&User{}, &UserList{})
+	SchemeBuilder.Register(&Group{}, &GroupList{})
+	SchemeBuilder.Register(&GroupMembership{}, &GroupMembershipList{})
+	SchemeBuilder.Register(&RoleGrant{}, &RoleGrantList{})
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-manage-roles/
+
+// RoleGrantParameters are the configurable fields of a RoleGrant.
+// +kubebuilder:validation:XValidation:rule="has(self.roleId) || has(self.roleName)",message="either roleId or roleName must be set"
+type RoleGrantParameters struct {
+	// ID of the group the role is granted to.
+	// +optional
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupRef is a reference to a Group used to set the GroupID.
+	// +optional
+	GroupRef *xpv1.Reference `json:"groupRef,omitempty"`
+
+	// GroupSelector selects references to a Group used to set the GroupID.
+	// +optional
+	GroupSelector *xpv1.Selector `json:"groupSelector,omitempty"`
+
+	// ID of the role.
+	// +optional
+	RoleID *string `json:"roleId,omitempty"`
+
+	// Name of the role, e.g. all_product_admin, used to look up the role ID
+	// when roleId is not set.
+	// +optional
+	RoleName *string `json:"roleName,omitempty"`
+
+	// ID of the account the role is granted on. Defaults to the account of the ProviderConfig
+	// and must be allowed by it.
+	// +optional
+	AccountID *int `json:"accountId,omitempty"`
+}
+
+// RoleGrantObservation are the observable fields of a RoleGrant.
+type RoleGrantObservation struct {
+	// ID of the granted role.
+	RoleID string `json:"roleId,omitempty"`
+	// ID of the account the role is granted on.
+	AccountID int `json:"accountId,omitempty"`
+	// Display name of the role.
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// A RoleGrantSpec defines the desired state of a RoleGrant.
+type RoleGrantSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RoleGrantParameters `json:"forProvider"`
+}
+
+// A RoleGrantStatus represents the observed state of a RoleGrant.
+type RoleGrantStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RoleGrantObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RoleGrant grants a role on an account to a Group.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".status.atProvider.displayName"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.atProvider.accountId"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type RoleGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RoleGrantSpec   `json:"spec"`
+	Status RoleGrantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RoleGrantList contains a list of RoleGrant
+type RoleGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RoleGrant `json:"items"`
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-manage-users/
+
+// UserParameters are the configurable fields of a User.
+type UserParameters struct {
+	// ID of the authentication domain the user belongs to.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="authenticationDomainId is immutable"
+	AuthenticationDomainID string `json:"authenticationDomainId"`
+
+	// Email of the user. An existing user with this email is adopted.
+	Email string `json:"email"`
+
+	// Name of the user.
+	Name string `json:"name"`
+
+	// The user type, which decides what the user has access to and is billed for.
+	// +kubebuilder:validation:Enum=BASIC_USER_TIER;CORE_USER_TIER;FULL_USER_TIER
+	// +kubebuilder:default=BASIC_USER_TIER
+	// +optional
+	UserType string `json:"userType,omitempty"`
+
+	// Time zone of the user, e.g. Europe/Berlin.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// UserObservation are the observable fields of a User.
+type UserObservation struct {
+	// The user identifier.
+	ID string `json:"id,omitempty"`
+	// Whether the user verified their email, e.g. Pending or Verified.
+	EmailVerificationState string `json:"emailVerificationState,omitempty"`
+	// The user type, e.g. Basic.
+	Type string `json:"type,omitempty"`
+	// When the user was last active.
+	LastActive string `json:"lastActive,omitempty"`
+}
+
+// A UserSpec defines the desired state of a User.
+type UserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserParameters `json:"forProvider"`
+}
+
+// A UserStatus represents the observed state of a User.
+type UserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A User is a New Relic user in an authentication domain.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="EMAIL",type="string",JSONPath=".spec.forProvider.email"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,newrelic}
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec   `json:"spec"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of User
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
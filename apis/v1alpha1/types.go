@@ -27,9 +27,48 @@ type ProviderConfigSpec struct {
 	Credentials ProviderCredentials `json:"credentials"`
 	// AccountID required by nerdgraph API
 	AccountID string `json:"account_id,omitempty"`
+	// AccountIDs are further accounts that managed resources may target with
+	// their accountId. AccountID is the default and is always allowed.
+	// +optional
+	AccountIDs []string `json:"account_ids,omitempty"`
 	// Region of the account
 	// +kubebuilder:validation:Enum=US;EU
 	Region *string `json:"region,omitempty"`
+	// Endpoints override the API base URLs of the region, e.g. to point the
+	// provider at a local stand-in for testing.
+	// +optional
+	Endpoints *ProviderEndpoints `json:"endpoints,omitempty"`
+	// HTTP configures the connections to the New Relic APIs.
+	// +optional
+	HTTP *ProviderHTTPConfig `json:"http,omitempty"`
+}
+
+// ProviderEndpoints are base URLs of the New Relic APIs.
+type ProviderEndpoints struct {
+	// NerdGraph base URL, e.g. https://api.newrelic.com/graphql.
+	// +optional
+	NerdGraph *string `json:"nerdGraph,omitempty"`
+	// REST API v2 base URL, e.g. https://api.newrelic.com/v2.
+	// +optional
+	REST *string `json:"rest,omitempty"`
+	// Synthetics API base URL, e.g. https://synthetics.newrelic.com/synthetics/api.
+	// +optional
+	Synthetics *string `json:"synthetics,omitempty"`
+}
+
+// ProviderHTTPConfig configures the HTTP client of the provider.
+type ProviderHTTPConfig struct {
+	// ProxyURL of the HTTP(S) proxy requests are sent through. The proxy
+	// environment variables of the provider are used when unset.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+	// CABundleSecretRef selects a Secret key holding PEM encoded CA
+	// certificates, trusted in addition to the system ones.
+	// +optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+	// Timeout of a request, e.g. 30s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -44,12 +83,43 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// Name of the default account the credentials were validated against.
+	// +optional
+	AccountName string `json:"accountName,omitempty"`
+
+	// Accounts the credentials were validated against: the default account,
+	// then the further accounts the ProviderConfig allows.
+	// +optional
+	Accounts []ProviderConfigAccount `json:"accounts,omitempty"`
+
+	// Region the credentials were validated against.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Type of the API key, e.g. USER or LICENSE.
+	// +optional
+	KeyType string `json:"keyType,omitempty"`
+
+	// Time the credentials were last validated.
+	// +optional
+	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
+}
+
+// A ProviderConfigAccount is an account the credentials were validated against.
+type ProviderConfigAccount struct {
+	// ID of the account.
+	ID int `json:"id"`
+	// Name of the account.
+	Name string `json:"name"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures a Template provider.
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.accountName"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster
@@ -0,0 +1,153 @@
+package nr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+)
+
+// DefaultClientCache is the client cache shared by all controllers
+var DefaultClientCache = NewClientCache()
+
+// DefaultCredentialsTTL is how long a cached client is used before the Secrets
+// it was built from are read again
+const DefaultCredentialsTTL = 5 * time.Minute
+
+// A ClientCache reuses New Relic clients, and so their HTTP connections, across
+// reconciles. A client is kept per ProviderConfig and account, as requests are
+// rate limited per account, and replaced when the spec of
+// the ProviderConfig or the credentials it points to change. Updates of its
+// status, e.g. by the usage tracker, keep the client.
+type ClientCache struct {
+	mu      sync.Mutex
+	clients map[clientKey]cachedClient
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+type clientKey struct {
+	uid     types.UID
+	account int
+}
+
+type cachedClient struct {
+	name        string
+	generation  int64
+	credentials string
+	checked     time.Time
+	client      *newrelic.NewRelic
+}
+
+// NewClientCache returns an empty client cache
+func NewClientCache() *ClientCache {
+	return &ClientCache{clients: map[clientKey]cachedClient{}, ttl: DefaultCredentialsTTL, now: time.Now}
+}
+
+// Get returns the client of a provider config for an account, creating it when
+// the cache holds none for the current generation of the provider config and
+// its credentials. The credentials are only read again once the TTL of the
+// cached client passed. The cache is only locked to look up and store clients,
+// so a slow API server does not hold up the other provider configs; a client
+// missed by concurrent reconciles may be built more than once.
+func (c *ClientCache) Get(ctx context.Context, kube client.Client, pc *apisv1alpha1.ProviderConfig, accountID int) (*newrelic.NewRelic, error) {
+	key := clientKey{uid: pc.GetUID(), account: accountID}
+	c.mu.Lock()
+	cc, ok := c.clients[key]
+	c.mu.Unlock()
+	if ok && cc.generation == pc.GetGeneration() && c.now().Sub(cc.checked) < c.ttl {
+		return cc.client, nil
+	}
+
+	apiKey, err := ExtractNewRelicAPIKey(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+	var caBundle []byte
+	if pc.Spec.HTTP != nil && pc.Spec.HTTP.CABundleSecretRef != nil {
+		if caBundle, err = extractSecretKey(ctx, kube, *pc.Spec.HTTP.CABundleSecretRef); err != nil {
+			return nil, errors.Wrap(err, errGetCABundle)
+		}
+	}
+	credentials := credentialsHash(apiKey, caBundle)
+	if ok && cc.generation == pc.GetGeneration() && cc.credentials == credentials {
+		cc.checked = c.now()
+		c.store(key, cc)
+		return cc.client, nil
+	}
+
+	opts, err := clientOptions(pc, accountID, caBundle)
+	if err != nil {
+		return nil, err
+	}
+	nrClient, err := GetNewRelicClient(apiKey, pc.Spec.Region, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, cachedClient{name: pc.GetName(), generation: pc.GetGeneration(), credentials: credentials, checked: c.now(), client: nrClient})
+	return nrClient, nil
+}
+
+func (c *ClientCache) store(key clientKey, cc cachedClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clients[key] = cc
+}
+
+// Delete drops the clients of a provider config
+func (c *ClientCache) Delete(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.clients {
+		if key.uid == uid {
+			delete(c.clients, key)
+		}
+	}
+}
+
+// DeleteByName drops the clients of a provider config that can no longer be
+// read, e.g. because it was deleted before its deletion was reconciled
+func (c *ClientCache) DeleteByName(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, cc := range c.clients {
+		if cc.name == name {
+			delete(c.clients, key)
+		}
+	}
+}
+
+// Len returns the number of cached clients
+func (c *ClientCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.clients)
+}
+
+// credentialsHash identifies the credentials a client was built from, without
+// keeping them in memory
+func credentialsHash(apiKey string, caBundle []byte) string {
+	h := sha256.New()
+	h.Write([]byte(apiKey))
+	h.Write([]byte{0})
+	h.Write(caBundle)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func extractSecretKey(ctx context.Context, kube client.Client, ref xpv1.SecretKeySelector) ([]byte, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, err
+	}
+	return s.Data[ref.Key], nil
+}
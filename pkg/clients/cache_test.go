@@ -0,0 +1,238 @@
+package nr
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.openly.dev/pointy"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+)
+
+func TestClientCache(t *testing.T) {
+	type change struct {
+		resourceVersion string
+		generation      int64
+		apiKey          string
+		expired         bool
+	}
+
+	type want struct {
+		reused bool
+		reads  int
+	}
+
+	cases := map[string]struct {
+		change change
+		want   want
+	}{
+		"Unchanged": {
+			change: change{resourceVersion: "1", generation: 1, apiKey: "NRAK-ONE"},
+			want:   want{reused: true, reads: 1},
+		},
+		"StatusUpdated": {
+			change: change{resourceVersion: "2", generation: 1, apiKey: "NRAK-ONE"},
+			want:   want{reused: true, reads: 1},
+		},
+		"SpecChanged": {
+			change: change{resourceVersion: "2", generation: 2, apiKey: "NRAK-ONE"},
+			want:   want{reads: 2},
+		},
+		"APIKeyRotatedWithinTTL": {
+			change: change{resourceVersion: "1", generation: 1, apiKey: "NRAK-TWO"},
+			want:   want{reused: true, reads: 1},
+		},
+		"ExpiredUnchanged": {
+			change: change{resourceVersion: "1", generation: 1, apiKey: "NRAK-ONE", expired: true},
+			want:   want{reused: true, reads: 2},
+		},
+		"ExpiredAPIKeyRotated": {
+			change: change{resourceVersion: "1", generation: 1, apiKey: "NRAK-TWO", expired: true},
+			want:   want{reads: 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apiKey := "NRAK-ONE"
+			reads := 0
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					reads++
+					obj.(*corev1.Secret).Data = map[string][]byte{"credentials": []byte(apiKey)}
+					return nil
+				},
+			}
+			pc := &apisv1alpha1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{UID: "pc-uid", ResourceVersion: "1", Generation: 1},
+				Spec: apisv1alpha1.ProviderConfigSpec{
+					Credentials: apisv1alpha1.ProviderCredentials{
+						Source: xpv1.CredentialsSourceSecret,
+						CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+							SecretRef: &xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "nr"},
+								Key:             "credentials",
+							},
+						},
+					},
+				},
+			}
+
+			now := time.Now()
+			c := NewClientCache()
+			c.now = func() time.Time { return now }
+			first, err := c.Get(context.Background(), kube, pc, 1)
+			if err != nil {
+				t.Fatalf("c.Get(...): %v", err)
+			}
+			pc.SetResourceVersion(tc.change.resourceVersion)
+			pc.SetGeneration(tc.change.generation)
+			apiKey = tc.change.apiKey
+			if tc.change.expired {
+				now = now.Add(DefaultCredentialsTTL)
+			}
+			second, err := c.Get(context.Background(), kube, pc, 1)
+			if err != nil {
+				t.Fatalf("c.Get(...): %v", err)
+			}
+			if got := first == second; got != tc.want.reused {
+				t.Errorf("c.Get(...): reused client: want %t, got %t", tc.want.reused, got)
+			}
+			if reads != tc.want.reads {
+				t.Errorf("c.Get(...): Secret reads: want %d, got %d", tc.want.reads, reads)
+			}
+			if c.Len() != 1 {
+				t.Errorf("c.Len(): want 1, got %d", c.Len())
+			}
+		})
+	}
+}
+
+func TestClientCacheAccounts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"actor":{"user":{"name":"test"}}}}`))
+	}))
+	defer srv.Close()
+
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"credentials": []byte("NRAK-ONE")}
+			return nil
+		},
+	}
+	pc := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{UID: "pc-uid", Generation: 1},
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			AccountID:  "1111111",
+			AccountIDs: []string{"2222222"},
+			Credentials: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "nr"},
+						Key:             "credentials",
+					},
+				},
+			},
+			Endpoints: &apisv1alpha1.ProviderEndpoints{NerdGraph: pointy.String(srv.URL + "/graphql")},
+		},
+	}
+
+	const query = "{ actor { user { name } } }"
+	requests := func(account string) float64 {
+		return testutil.ToFloat64(DefaultAPIMetrics.requests.WithLabelValues(GraphQLOperation(query), unknownKind, account, OutcomeSuccess))
+	}
+	before := map[string]float64{"1111111": requests("1111111"), "2222222": requests("2222222")}
+
+	c := NewClientCache()
+	clients := map[int]*newrelic.NewRelic{}
+	for _, account := range []int{1111111, 2222222} {
+		nrClient, err := c.Get(context.Background(), kube, pc, account)
+		if err != nil {
+			t.Fatalf("c.Get(..., %d): %v", account, err)
+		}
+		clients[account] = nrClient
+		resp := struct{}{}
+		if err := nrClient.NerdGraph.QueryWithResponse(query, nil, &resp); err != nil {
+			t.Fatalf("NerdGraph query for account %d: %v", account, err)
+		}
+	}
+
+	if clients[1111111] == clients[2222222] {
+		t.Errorf("c.Get(...): want a client per account, got the same one")
+	}
+	for account, n := range before {
+		if got := requests(account) - n; got != 1 {
+			t.Errorf("requests of account %s: want 1, got %v", account, got)
+		}
+	}
+	if c.Len() != 2 {
+		t.Errorf("c.Len(): want 2, got %d", c.Len())
+	}
+	c.Delete(pc.GetUID())
+	if c.Len() != 0 {
+		t.Errorf("c.Delete(...): want no clients, got %d", c.Len())
+	}
+}
+
+func TestClientCacheCABundle(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"actor":{"user":{"name":"test"}}}}`))
+	}))
+	defer srv.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	reads := map[string]int{}
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			reads[key.Name]++
+			obj.(*corev1.Secret).Data = map[string][]byte{"credentials": []byte("NRAK-ONE"), "ca.crt": caBundle}
+			return nil
+		},
+	}
+	pc := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{UID: "pc-uid", Generation: 1},
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			Credentials: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "nr"},
+						Key:             "credentials",
+					},
+				},
+			},
+			Endpoints: &apisv1alpha1.ProviderEndpoints{NerdGraph: pointy.String(srv.URL + "/graphql")},
+			HTTP: &apisv1alpha1.ProviderHTTPConfig{
+				CABundleSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "ca"},
+					Key:             "ca.crt",
+				},
+			},
+		},
+	}
+
+	nrClient, err := NewClientCache().Get(context.Background(), kube, pc, 1)
+	if err != nil {
+		t.Fatalf("c.Get(...): %v", err)
+	}
+	if diff := cmp.Diff(map[string]int{"nr": 1, "ca": 1}, reads); diff != "" {
+		t.Errorf("c.Get(...): -want Secret reads, +got Secret reads:\n%s\n", diff)
+	}
+	resp := struct{}{}
+	if err := nrClient.NerdGraph.QueryWithResponse("{ actor { user { name } } }", nil, &resp); err != nil {
+		t.Errorf("NerdGraph query with the CA bundle: %v", err)
+	}
+}
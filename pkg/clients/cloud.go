@@ -0,0 +1,178 @@
+package nr
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+// CloudIntegrationSettings maps the NerdGraph name of a cloud integration, e.g. "lambda",
+// to its settings in the shape of the matching NerdGraph input type.
+// Names are matched case-insensitively.
+type CloudIntegrationSettings map[string]map[string]interface{}
+
+// GenerateCloudIntegrationSetting converts a spec integration into settings.
+// The "service" key naming the integration is dropped.
+func GenerateCloudIntegrationSetting(integration interface{}) (map[string]interface{}, error) {
+	setting := map[string]interface{}{}
+	out, err := json.Marshal(integration)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(out, &setting); err != nil {
+		return nil, err
+	}
+	delete(setting, "service")
+	return setting, nil
+}
+
+// GenerateCloudIntegrationsInput converts settings into a typed provider input such as
+// cloud.CloudAwsIntegrationsInput. Settings the service does not support are dropped.
+func GenerateCloudIntegrationsInput(linkedAccountID int, settings CloudIntegrationSettings, input interface{}) error {
+	// Same approach as the NRQL condition update input: build the json and let
+	// the typed input pick the fields it knows about
+	in := map[string][]map[string]interface{}{}
+	for name, setting := range settings {
+		integration := map[string]interface{}{"linkedAccountId": linkedAccountID}
+		for k, v := range setting {
+			integration[k] = v
+		}
+		in[name] = []map[string]interface{}{integration}
+	}
+	out, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(out, input)
+}
+
+// GenerateCloudDisableIntegrationsInput converts integration names into a typed provider
+// input such as cloud.CloudAwsDisableIntegrationsInput.
+func GenerateCloudDisableIntegrationsInput(linkedAccountID int, names []string, input interface{}) error {
+	settings := CloudIntegrationSettings{}
+	for _, name := range names {
+		settings[name] = map[string]interface{}{}
+	}
+	return GenerateCloudIntegrationsInput(linkedAccountID, settings, input)
+}
+
+// CloudIntegrationName returns the lower-cased NerdGraph name of an observed integration,
+// e.g. "awsmsk" for a CloudAwsMskIntegration.
+func CloudIntegrationName(integration cloud.CloudIntegrationInterface) string {
+	t := reflect.TypeOf(integration)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := strings.TrimSuffix(strings.TrimPrefix(t.Name(), "Cloud"), "Integration")
+	return strings.ToLower(name)
+}
+
+// GetCloudIntegrationSettings returns the settings and the IDs of the integrations enabled
+// on a linked account, keyed by lower-cased name.
+func GetCloudIntegrationSettings(account *cloud.CloudLinkedAccount) (CloudIntegrationSettings, map[string]int, error) {
+	settings := CloudIntegrationSettings{}
+	ids := map[string]int{}
+	for _, integration := range account.Integrations {
+		if integration == nil {
+			continue
+		}
+		setting, err := GenerateCloudIntegrationSetting(integration)
+		if err != nil {
+			return nil, nil, err
+		}
+		name := CloudIntegrationName(integration)
+		settings[name] = setting
+		if id, ok := setting["id"].(float64); ok {
+			ids[name] = int(id)
+		}
+	}
+	return settings, ids, nil
+}
+
+// CloudIntegrationsAreEqual reports whether every desired integration is enabled with the
+// desired settings and no other integration is enabled. Settings that are not set in
+// desired, or that the service does not support according to the typed input, are not
+// compared so server-side defaults don't cause drift.
+func CloudIntegrationsAreEqual(desired, observed CloudIntegrationSettings, input interface{}) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	supported := cloudIntegrationFields(input)
+	sortCmp := cmpopts.SortSlices(func(i, j interface{}) bool {
+		return fmt.Sprint(i) < fmt.Sprint(j)
+	})
+	for name, setting := range desired {
+		o, ok := observed[strings.ToLower(name)]
+		if !ok {
+			return false
+		}
+		for k, v := range setting {
+			if !supported[strings.ToLower(name)][k] {
+				continue
+			}
+			// Observed settings omit zero values
+			if _, ok := o[k]; !ok && (v == nil || reflect.ValueOf(v).IsZero()) {
+				continue
+			}
+			if !cmp.Equal(v, o[k], sortCmp, cmpopts.EquateEmpty()) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// cloudIntegrationFields returns the json keys each integration of a typed input accepts,
+// keyed by lower-cased integration name.
+func cloudIntegrationFields(input interface{}) map[string]map[string]bool {
+	fields := map[string]map[string]bool{}
+	t := reflect.TypeOf(input)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.ToLower(strings.Split(t.Field(i).Tag.Get("json"), ",")[0])
+		fields[name] = map[string]bool{}
+		elem := t.Field(i).Type
+		for elem.Kind() == reflect.Slice || elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Struct {
+			continue
+		}
+		for j := 0; j < elem.NumField(); j++ {
+			fields[name][strings.Split(elem.Field(j).Tag.Get("json"), ",")[0]] = true
+		}
+	}
+	return fields
+}
+
+// CloudIntegrationNamesToDisable returns the observed integrations that are not desired.
+// The names are returned in the casing of the typed input fields.
+func CloudIntegrationNamesToDisable(desired, observed CloudIntegrationSettings, input interface{}) []string {
+	keep := map[string]bool{}
+	for name := range desired {
+		keep[strings.ToLower(name)] = true
+	}
+	// Map the lower-cased observed names back to the json names of the input type
+	names := make([]string, 0)
+	t := reflect.TypeOf(input)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		lower := strings.ToLower(name)
+		if _, ok := observed[lower]; ok && !keep[lower] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
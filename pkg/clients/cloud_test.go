@@ -0,0 +1,235 @@
+package nr
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+func TestCloudIntegrationName(t *testing.T) {
+	cases := map[string]struct {
+		integration cloud.CloudIntegrationInterface
+		want        string
+	}{
+		"Lambda": {
+			integration: &cloud.CloudLambdaIntegration{},
+			want:        "lambda",
+		},
+		"CamelCase": {
+			integration: &cloud.CloudAwsMskIntegration{},
+			want:        "awsmsk",
+		},
+		"NilIntegration": {
+			integration: (*cloud.CloudSqsIntegration)(nil),
+			want:        "sqs",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CloudIntegrationName(tc.integration)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CloudIntegrationName(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCloudIntegrationsAreEqual(t *testing.T) {
+	type args struct {
+		desired  CloudIntegrationSettings
+		observed CloudIntegrationSettings
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NilAndEmpty": {
+			args: args{desired: nil, observed: CloudIntegrationSettings{}},
+			want: true,
+		},
+		"NilSettingAndEmptySetting": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": nil},
+				observed: CloudIntegrationSettings{"lambda": {}},
+			},
+			want: true,
+		},
+		"Unchanged": {
+			args: args{
+				desired: CloudIntegrationSettings{"lambda": {"metricsPollingInterval": float64(300), "awsRegions": []interface{}{"us-east-1", "eu-west-1"}}},
+				observed: CloudIntegrationSettings{"lambda": {
+					"id": float64(42), "name": "Lambda", "metricsPollingInterval": float64(300), "awsRegions": []interface{}{"eu-west-1", "us-east-1"},
+				}},
+			},
+			want: true,
+		},
+		"NameCase": {
+			args: args{
+				desired:  CloudIntegrationSettings{"awsMsk": {"metricsPollingInterval": float64(300)}},
+				observed: CloudIntegrationSettings{"awsmsk": {"metricsPollingInterval": float64(300)}},
+			},
+			want: true,
+		},
+		"ZeroValueOmitted": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": {"fetchTags": false, "tagKey": ""}},
+				observed: CloudIntegrationSettings{"lambda": {"metricsPollingInterval": float64(300)}},
+			},
+			want: true,
+		},
+		"UnsupportedField": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": {"notAField": "value"}},
+				observed: CloudIntegrationSettings{"lambda": {}},
+			},
+			want: true,
+		},
+		"ChangedField": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": {"metricsPollingInterval": float64(600)}},
+				observed: CloudIntegrationSettings{"lambda": {"metricsPollingInterval": float64(300)}},
+			},
+			want: false,
+		},
+		"AddedIntegration": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": {}, "sqs": {}},
+				observed: CloudIntegrationSettings{"lambda": {}},
+			},
+			want: false,
+		},
+		"RemovedIntegration": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": {}},
+				observed: CloudIntegrationSettings{"lambda": {}, "sqs": {}},
+			},
+			want: false,
+		},
+		"ReplacedIntegration": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": {}},
+				observed: CloudIntegrationSettings{"sqs": {}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CloudIntegrationsAreEqual(tc.args.desired, tc.args.observed, cloud.CloudAwsIntegrationsInput{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CloudIntegrationsAreEqual(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCloudIntegrationNamesToDisable(t *testing.T) {
+	type args struct {
+		desired  CloudIntegrationSettings
+		observed CloudIntegrationSettings
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"NilAndEmpty": {
+			args: args{desired: nil, observed: CloudIntegrationSettings{}},
+			want: []string{},
+		},
+		"Unchanged": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": {}, "sqs": {}},
+				observed: CloudIntegrationSettings{"lambda": {}, "sqs": {}},
+			},
+			want: []string{},
+		},
+		"RemovedIntegrations": {
+			args: args{
+				desired:  CloudIntegrationSettings{"lambda": {}},
+				observed: CloudIntegrationSettings{"lambda": {}, "sqs": {}, "awsmsk": {}},
+			},
+			want: []string{"awsMsk", "sqs"},
+		},
+		"AllRemoved": {
+			args: args{
+				desired:  nil,
+				observed: CloudIntegrationSettings{"s3": {}},
+			},
+			want: []string{"s3"},
+		},
+		"NameCase": {
+			args: args{
+				desired:  CloudIntegrationSettings{"awsMsk": {}},
+				observed: CloudIntegrationSettings{"awsmsk": {}},
+			},
+			want: []string{},
+		},
+		"UnknownObserved": {
+			args: args{
+				desired:  CloudIntegrationSettings{},
+				observed: CloudIntegrationSettings{"notanintegration": {}},
+			},
+			want: []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CloudIntegrationNamesToDisable(tc.args.desired, tc.args.observed, cloud.CloudAwsIntegrationsInput{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CloudIntegrationNamesToDisable(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestGetCloudIntegrationSettings(t *testing.T) {
+	type want struct {
+		names []string
+		ids   map[string]int
+	}
+
+	cases := map[string]struct {
+		account *cloud.CloudLinkedAccount
+		want    want
+	}{
+		"NoIntegrations": {
+			account: &cloud.CloudLinkedAccount{},
+			want:    want{names: []string{}, ids: map[string]int{}},
+		},
+		"NilIntegration": {
+			account: &cloud.CloudLinkedAccount{Integrations: []cloud.CloudIntegrationInterface{nil, &cloud.CloudLambdaIntegration{ID: 42}}},
+			want:    want{names: []string{"lambda"}, ids: map[string]int{"lambda": 42}},
+		},
+		"EmptyIntegration": {
+			account: &cloud.CloudLinkedAccount{Integrations: []cloud.CloudIntegrationInterface{&cloud.CloudSqsIntegration{}}},
+			want:    want{names: []string{"sqs"}, ids: map[string]int{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			settings, ids, err := GetCloudIntegrationSettings(tc.account)
+			if err != nil {
+				t.Fatalf("GetCloudIntegrationSettings(...): %v", err)
+			}
+			names := make([]string, 0, len(settings))
+			for n := range settings {
+				names = append(names, n)
+			}
+			sort.Strings(names)
+			if diff := cmp.Diff(tc.want.names, names); diff != "" {
+				t.Errorf("GetCloudIntegrationSettings(...): -want integrations, +got integrations:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.ids, ids); diff != "" {
+				t.Errorf("GetCloudIntegrationSettings(...): -want ids, +got ids:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package nr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// maxSummaryFields is how many drifted fields a summary lists
+const maxSummaryFields = 5
+
+// A Drift is how an external resource differs from the desired state of its
+// managed resource.
+type Drift struct {
+	// Fields are the paths of the fields that differ, e.g. Pages[0].Name
+	Fields []string
+	// Diff is the difference between the desired and the observed state,
+	// -desired +observed
+	Diff string
+}
+
+// Compare returns how an observed value drifted from the desired one
+func Compare(desired, observed interface{}, opts ...cmp.Option) Drift {
+	d := Drift{}
+	d.Field("", desired, observed, opts...)
+	return d
+}
+
+// Field compares a field of the desired and the observed state and adds the
+// paths that differ, prefixed by the name of the field
+func (d *Drift) Field(name string, desired, observed interface{}, opts ...cmp.Option) {
+	r := &pathReporter{}
+	if cmp.Equal(desired, observed, append(opts, cmp.Reporter(r))...) {
+		return
+	}
+	seen := map[string]bool{}
+	for _, p := range r.paths {
+		// A replaced element is reported as removed and as added
+		if p = strings.TrimPrefix(name+p, "."); !seen[p] {
+			seen[p] = true
+			d.Fields = append(d.Fields, p)
+		}
+	}
+	d.addDiff(name, cmp.Diff(desired, observed, opts...))
+}
+
+// Mismatch adds a field known to differ, for fields compared by custom rules
+// rather than by cmp
+func (d *Drift) Mismatch(name string, desired, observed interface{}) {
+	d.Fields = append(d.Fields, name)
+	d.addDiff(name, cmp.Diff(desired, observed))
+}
+
+func (d *Drift) addDiff(name, diff string) {
+	if name != "" {
+		diff = name + ":\n" + diff
+	}
+	d.Diff += diff
+}
+
+// UpToDate returns whether no field drifted
+func (d Drift) UpToDate() bool {
+	return len(d.Fields) == 0
+}
+
+// Summary lists the fields that drifted, e.g. for an event
+func (d Drift) Summary() string {
+	if len(d.Fields) <= maxSummaryFields {
+		return strings.Join(d.Fields, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(d.Fields[:maxSummaryFields], ", "), len(d.Fields)-maxSummaryFields)
+}
+
+// pathReporter keeps the paths of the values cmp found to differ
+type pathReporter struct {
+	path  cmp.Path
+	paths []string
+}
+
+func (r *pathReporter) PushStep(ps cmp.PathStep) {
+	r.path = append(r.path, ps)
+}
+
+func (r *pathReporter) Report(rs cmp.Result) {
+	if rs.Equal() {
+		return
+	}
+	var b strings.Builder
+	for _, ps := range r.path {
+		switch s := ps.(type) {
+		case cmp.StructField:
+			b.WriteString("." + s.Name())
+		case cmp.SliceIndex:
+			// Added or removed elements only have an index on one side
+			i, j := s.SplitKeys()
+			if i < 0 {
+				i = j
+			}
+			fmt.Fprintf(&b, "[%d]", i)
+		case cmp.MapIndex:
+			fmt.Fprintf(&b, "[%v]", s.Key())
+		}
+	}
+	r.paths = append(r.paths, b.String())
+}
+
+func (r *pathReporter) PopStep() {
+	r.path = r.path[:len(r.path)-1]
+}
@@ -0,0 +1,105 @@
+package nr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type page struct {
+	Name    string
+	Widgets []widget
+	Tags    map[string]string
+}
+
+type widget struct {
+	Title string
+}
+
+func TestCompare(t *testing.T) {
+	cases := map[string]struct {
+		desired  interface{}
+		observed interface{}
+		opts     []cmp.Option
+		want     []string
+	}{
+		"Equal": {
+			desired:  page{Name: "a", Widgets: []widget{{Title: "w"}}},
+			observed: page{Name: "a", Widgets: []widget{{Title: "w"}}},
+		},
+		"EquateEmpty": {
+			desired:  page{Name: "a", Widgets: []widget{}},
+			observed: page{Name: "a"},
+			opts:     []cmp.Option{cmpopts.EquateEmpty()},
+		},
+		"Field": {
+			desired:  page{Name: "a"},
+			observed: page{Name: "b"},
+			want:     []string{"Name"},
+		},
+		"NestedField": {
+			desired:  &page{Widgets: []widget{{Title: "w"}, {Title: "x"}}},
+			observed: &page{Widgets: []widget{{Title: "w"}, {Title: "y"}}},
+			want:     []string{"Widgets[1].Title"},
+		},
+		"AddedElement": {
+			desired:  page{Widgets: []widget{{Title: "w"}}},
+			observed: page{Widgets: []widget{{Title: "w"}, {Title: "x"}}},
+			want:     []string{"Widgets[1]"},
+		},
+		"MapValue": {
+			desired:  page{Tags: map[string]string{"team": "a"}},
+			observed: page{Tags: map[string]string{"team": "b"}},
+			want:     []string{"Tags[team]"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Compare(tc.desired, tc.observed, tc.opts...)
+			if diff := cmp.Diff(tc.want, got.Fields); diff != "" {
+				t.Errorf("Compare(...): -want fields, +got fields:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(len(tc.want) == 0, got.UpToDate()); diff != "" {
+				t.Errorf("UpToDate(): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(len(tc.want) == 0, got.Diff == ""); diff != "" {
+				t.Errorf("Compare(...): -want empty diff, +got empty diff:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDriftField(t *testing.T) {
+	d := Drift{}
+	d.Field("Name", "a", "a")
+	d.Field("Page", page{Name: "a"}, page{Name: "b"})
+	d.Mismatch("Terms", []string{"x"}, []string{"y"})
+
+	if diff := cmp.Diff([]string{"Page.Name", "Terms"}, d.Fields); diff != "" {
+		t.Errorf("Fields: -want, +got:\n%s\n", diff)
+	}
+	if !strings.Contains(d.Diff, "Page:\n") || !strings.Contains(d.Diff, "Terms:\n") {
+		t.Errorf("Diff: want the diffs of Page and Terms, got:\n%s", d.Diff)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	cases := map[string]struct {
+		fields []string
+		want   string
+	}{
+		"Few":  {fields: []string{"Name", "Terms"}, want: "Name, Terms"},
+		"Many": {fields: []string{"a", "b", "c", "d", "e", "f", "g"}, want: "a, b, c, d, e and 2 more"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Drift{Fields: tc.fields}.Summary()); diff != "" {
+				t.Errorf("Summary(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package nr
+
+import (
+	"crypto/tls"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+)
+
+// ESSTLSConfig returns the TLS config to reach External Secret Store plugins, or
+// nil when none was loaded. The ESS options are only set when External Secret
+// Stores are enabled on the command line, so they may be nil.
+func ESSTLSConfig(o controller.Options) *tls.Config {
+	if o.ESSOptions == nil {
+		return nil
+	}
+	return o.ESSOptions.TLSConfig
+}
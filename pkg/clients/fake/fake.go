@@ -0,0 +1,161 @@
+// Package fake provides fakes of the New Relic API clients and of the event
+// recorder for controller tests.
+package fake
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+)
+
+var _ nr.AlertsClient = &MockAlertsClient{}
+
+// MockAlertsClient is a fake of the alerts API, whose methods call the Mock function fields.
+type MockAlertsClient struct {
+	MockQueryPolicyWithContext                         func(ctx context.Context, accountID int, id string) (*alerts.AlertsPolicy, error)
+	MockListPoliciesWithContext                        func(ctx context.Context, params *alerts.ListPoliciesParams) ([]alerts.Policy, error)
+	MockCreatePolicyMutationWithContext                func(ctx context.Context, accountID int, policy alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error)
+	MockUpdatePolicyMutationWithContext                func(ctx context.Context, accountID int, policyID string, policy alerts.AlertsPolicyUpdateInput) (*alerts.AlertsPolicy, error)
+	MockUpdatePolicyChannelsWithContext                func(ctx context.Context, policyID int, channelIDs []int) (*alerts.PolicyChannels, error)
+	MockDeletePolicyMutationWithContext                func(ctx context.Context, accountID int, id string) (*alerts.AlertsPolicy, error)
+	MockGetNrqlConditionQueryWithContext               func(ctx context.Context, accountID int, conditionID string) (*alerts.NrqlAlertCondition, error)
+	MockSearchNrqlConditionsQueryWithContext           func(ctx context.Context, accountID int, searchCriteria alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error)
+	MockCreateNrqlConditionStaticMutationWithContext   func(ctx context.Context, accountID int, policyID string, nrqlCondition alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error)
+	MockCreateNrqlConditionBaselineMutationWithContext func(ctx context.Context, accountID int, policyID string, nrqlCondition alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error)
+	MockUpdateNrqlConditionStaticMutationWithContext   func(ctx context.Context, accountID int, conditionID string, nrqlCondition alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error)
+	MockUpdateNrqlConditionBaselineMutationWithContext func(ctx context.Context, accountID int, conditionID string, nrqlCondition alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error)
+	MockDeleteNrqlConditionMutationWithContext         func(ctx context.Context, accountID int, conditionID string) (string, error)
+}
+
+// QueryPolicyWithContext calls MockQueryPolicyWithContext.
+func (m *MockAlertsClient) QueryPolicyWithContext(ctx context.Context, accountID int, id string) (*alerts.AlertsPolicy, error) {
+	return m.MockQueryPolicyWithContext(ctx, accountID, id)
+}
+
+// ListPoliciesWithContext calls MockListPoliciesWithContext.
+func (m *MockAlertsClient) ListPoliciesWithContext(ctx context.Context, params *alerts.ListPoliciesParams) ([]alerts.Policy, error) {
+	return m.MockListPoliciesWithContext(ctx, params)
+}
+
+// CreatePolicyMutationWithContext calls MockCreatePolicyMutationWithContext.
+func (m *MockAlertsClient) CreatePolicyMutationWithContext(ctx context.Context, accountID int, policy alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error) {
+	return m.MockCreatePolicyMutationWithContext(ctx, accountID, policy)
+}
+
+// UpdatePolicyMutationWithContext calls MockUpdatePolicyMutationWithContext.
+func (m *MockAlertsClient) UpdatePolicyMutationWithContext(ctx context.Context, accountID int, policyID string, policy alerts.AlertsPolicyUpdateInput) (*alerts.AlertsPolicy, error) {
+	return m.MockUpdatePolicyMutationWithContext(ctx, accountID, policyID, policy)
+}
+
+// UpdatePolicyChannelsWithContext calls MockUpdatePolicyChannelsWithContext.
+func (m *MockAlertsClient) UpdatePolicyChannelsWithContext(ctx context.Context, policyID int, channelIDs []int) (*alerts.PolicyChannels, error) {
+	return m.MockUpdatePolicyChannelsWithContext(ctx, policyID, channelIDs)
+}
+
+// DeletePolicyMutationWithContext calls MockDeletePolicyMutationWithContext.
+func (m *MockAlertsClient) DeletePolicyMutationWithContext(ctx context.Context, accountID int, id string) (*alerts.AlertsPolicy, error) {
+	return m.MockDeletePolicyMutationWithContext(ctx, accountID, id)
+}
+
+// GetNrqlConditionQueryWithContext calls MockGetNrqlConditionQueryWithContext.
+func (m *MockAlertsClient) GetNrqlConditionQueryWithContext(ctx context.Context, accountID int, conditionID string) (*alerts.NrqlAlertCondition, error) {
+	return m.MockGetNrqlConditionQueryWithContext(ctx, accountID, conditionID)
+}
+
+// SearchNrqlConditionsQueryWithContext calls MockSearchNrqlConditionsQueryWithContext.
+func (m *MockAlertsClient) SearchNrqlConditionsQueryWithContext(ctx context.Context, accountID int, searchCriteria alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error) {
+	return m.MockSearchNrqlConditionsQueryWithContext(ctx, accountID, searchCriteria)
+}
+
+// CreateNrqlConditionStaticMutationWithContext calls MockCreateNrqlConditionStaticMutationWithContext.
+func (m *MockAlertsClient) CreateNrqlConditionStaticMutationWithContext(ctx context.Context, accountID int, policyID string, nrqlCondition alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error) {
+	return m.MockCreateNrqlConditionStaticMutationWithContext(ctx, accountID, policyID, nrqlCondition)
+}
+
+// CreateNrqlConditionBaselineMutationWithContext calls MockCreateNrqlConditionBaselineMutationWithContext.
+func (m *MockAlertsClient) CreateNrqlConditionBaselineMutationWithContext(ctx context.Context, accountID int, policyID string, nrqlCondition alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error) {
+	return m.MockCreateNrqlConditionBaselineMutationWithContext(ctx, accountID, policyID, nrqlCondition)
+}
+
+// UpdateNrqlConditionStaticMutationWithContext calls MockUpdateNrqlConditionStaticMutationWithContext.
+func (m *MockAlertsClient) UpdateNrqlConditionStaticMutationWithContext(ctx context.Context, accountID int, conditionID string, nrqlCondition alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error) {
+	return m.MockUpdateNrqlConditionStaticMutationWithContext(ctx, accountID, conditionID, nrqlCondition)
+}
+
+// UpdateNrqlConditionBaselineMutationWithContext calls MockUpdateNrqlConditionBaselineMutationWithContext.
+func (m *MockAlertsClient) UpdateNrqlConditionBaselineMutationWithContext(ctx context.Context, accountID int, conditionID string, nrqlCondition alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error) {
+	return m.MockUpdateNrqlConditionBaselineMutationWithContext(ctx, accountID, conditionID, nrqlCondition)
+}
+
+// DeleteNrqlConditionMutationWithContext calls MockDeleteNrqlConditionMutationWithContext.
+func (m *MockAlertsClient) DeleteNrqlConditionMutationWithContext(ctx context.Context, accountID int, conditionID string) (string, error) {
+	return m.MockDeleteNrqlConditionMutationWithContext(ctx, accountID, conditionID)
+}
+
+var _ nr.DashboardsClient = &MockDashboardsClient{}
+
+// MockDashboardsClient is a fake of the dashboards API, whose methods call the Mock function fields.
+type MockDashboardsClient struct {
+	MockGetDashboardEntityWithContext func(ctx context.Context, guid common.EntityGUID) (*entities.DashboardEntity, error)
+	MockDashboardCreateWithContext    func(ctx context.Context, accountID int, dashboard dashboards.DashboardInput) (*dashboards.DashboardCreateResult, error)
+	MockDashboardUpdateWithContext    func(ctx context.Context, dashboard dashboards.DashboardInput, guid common.EntityGUID) (*dashboards.DashboardUpdateResult, error)
+	MockDashboardDeleteWithContext    func(ctx context.Context, guid common.EntityGUID) (*dashboards.DashboardDeleteResult, error)
+}
+
+// GetDashboardEntityWithContext calls MockGetDashboardEntityWithContext.
+func (m *MockDashboardsClient) GetDashboardEntityWithContext(ctx context.Context, guid common.EntityGUID) (*entities.DashboardEntity, error) {
+	return m.MockGetDashboardEntityWithContext(ctx, guid)
+}
+
+// DashboardCreateWithContext calls MockDashboardCreateWithContext.
+func (m *MockDashboardsClient) DashboardCreateWithContext(ctx context.Context, accountID int, dashboard dashboards.DashboardInput) (*dashboards.DashboardCreateResult, error) {
+	return m.MockDashboardCreateWithContext(ctx, accountID, dashboard)
+}
+
+// DashboardUpdateWithContext calls MockDashboardUpdateWithContext.
+func (m *MockDashboardsClient) DashboardUpdateWithContext(ctx context.Context, dashboard dashboards.DashboardInput, guid common.EntityGUID) (*dashboards.DashboardUpdateResult, error) {
+	return m.MockDashboardUpdateWithContext(ctx, dashboard, guid)
+}
+
+// DashboardDeleteWithContext calls MockDashboardDeleteWithContext.
+func (m *MockDashboardsClient) DashboardDeleteWithContext(ctx context.Context, guid common.EntityGUID) (*dashboards.DashboardDeleteResult, error) {
+	return m.MockDashboardDeleteWithContext(ctx, guid)
+}
+
+var _ nr.EntitiesClient = &MockEntitiesClient{}
+
+// MockEntitiesClient is a fake of the entities API, whose methods call the Mock function fields.
+type MockEntitiesClient struct {
+	MockGetEntitySearchByQueryWithContext func(ctx context.Context, options entities.EntitySearchOptions, query string, sortBy []entities.EntitySearchSortCriteria) (*entities.EntitySearch, error)
+}
+
+// GetEntitySearchByQueryWithContext calls MockGetEntitySearchByQueryWithContext.
+func (m *MockEntitiesClient) GetEntitySearchByQueryWithContext(ctx context.Context, options entities.EntitySearchOptions, query string, sortBy []entities.EntitySearchSortCriteria) (*entities.EntitySearch, error) {
+	return m.MockGetEntitySearchByQueryWithContext(ctx, options, query, sortBy)
+}
+
+var _ event.Recorder = &EventRecorder{}
+
+// EventRecorder is a fake event recorder that keeps the reasons of the events
+// it records.
+type EventRecorder struct {
+	Reasons []event.Reason
+}
+
+// Event records the reason of an event
+func (r *EventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.Reasons = append(r.Reasons, e.Reason)
+}
+
+// WithAnnotations returns the recorder itself
+func (r *EventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
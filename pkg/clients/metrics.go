@@ -0,0 +1,290 @@
+package nr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+)
+
+const (
+	// OutcomeSuccess is the outcome of requests New Relic answered without
+	// errors. Failed requests are labelled with their nrerrors.Reason.
+	OutcomeSuccess = "Success"
+
+	unknownKind      = "unknown"
+	unknownOperation = "unknown"
+
+	// deprecationPrefix starts the NerdGraph warnings the client ignores
+	deprecationPrefix = "This field is deprecated!"
+	// alertsNotFound is the message of the alerts API for a missing policy
+	// or condition
+	alertsNotFound = "Not Found"
+)
+
+// DefaultAPIMetrics are the New Relic API metrics shared by all controllers
+var DefaultAPIMetrics = NewAPIMetrics()
+
+// APIMetrics count and time the requests to the New Relic APIs by operation,
+// resource kind, account and outcome.
+type APIMetrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewAPIMetrics returns unregistered New Relic API metrics
+func NewAPIMetrics() *APIMetrics {
+	labels := []string{"operation", "kind", "account", "outcome"}
+	return &APIMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "provider_newrelic_api_requests_total",
+			Help: "Requests to the New Relic APIs, by NerdGraph field or REST path, managed resource kind, account and outcome.",
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "provider_newrelic_api_request_duration_seconds",
+			Help:    "Latency of the requests to the New Relic APIs, by NerdGraph field or REST path, managed resource kind, account and outcome.",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, labels),
+	}
+}
+
+// Transport wraps an HTTP transport so that its requests are counted and timed
+// for an account
+func (m *APIMetrics) Transport(account string, next http.RoundTripper) http.RoundTripper {
+	return &instrumentedTransport{metrics: m, account: account, next: next}
+}
+
+// Describe implements prometheus.Collector
+func (m *APIMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.duration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (m *APIMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.duration.Collect(ch)
+}
+
+type instrumentedTransport struct {
+	metrics *APIMetrics
+	account string
+	next    http.RoundTripper
+}
+
+// RoundTrip sends the request and records it under its operation, the kind of
+// managed resource in its context and the outcome of the response
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operation, err := Operation(req)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = string(nrerrors.ReasonFor(err))
+	} else if outcome, err = Outcome(resp); err != nil {
+		return nil, err
+	}
+	labels := prometheus.Labels{"operation": operation, "kind": ResourceKind(req.Context()), "account": t.account, "outcome": outcome}
+	t.metrics.duration.With(labels).Observe(time.Since(start).Seconds())
+	t.metrics.requests.With(labels).Inc()
+	return resp, err
+}
+
+type resourceKindKey struct{}
+
+// WithResourceKind returns a context whose New Relic API requests are recorded
+// for a kind of managed resource
+func WithResourceKind(ctx context.Context, kind string) context.Context {
+	return context.WithValue(ctx, resourceKindKey{}, kind)
+}
+
+// ResourceKind returns the kind of managed resource a context is for
+func ResourceKind(ctx context.Context) string {
+	if kind, ok := ctx.Value(resourceKindKey{}).(string); ok {
+		return kind
+	}
+	return unknownKind
+}
+
+// InstrumentConnecter records the New Relic API requests of the external
+// clients a connecter produces for a kind of managed resource
+func InstrumentConnecter(kind string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		ext, err := c.Connect(WithResourceKind(ctx, kind), mg)
+		if err != nil {
+			return nil, err
+		}
+		return managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+				return ext.Observe(WithResourceKind(ctx, kind), mg)
+			},
+			CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+				return ext.Create(WithResourceKind(ctx, kind), mg)
+			},
+			UpdateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+				return ext.Update(WithResourceKind(ctx, kind), mg)
+			},
+			DeleteFn: func(ctx context.Context, mg resource.Managed) error {
+				return ext.Delete(WithResourceKind(ctx, kind), mg)
+			},
+		}, nil
+	})
+}
+
+// numericSegment matches the IDs in REST paths, which would make every
+// resource an operation of its own
+var numericSegment = regexp.MustCompile(`/\d+`)
+
+// Operation names a request after its NerdGraph field, e.g. alertsPolicyCreate,
+// or its REST method and path, e.g. GET /v2/alerts_policies.json. The body of
+// a NerdGraph request is buffered so that it can still be sent.
+func Operation(req *http.Request) (string, error) {
+	if !strings.HasSuffix(req.URL.Path, "/graphql") || req.Body == nil {
+		return req.Method + " " + numericSegment.ReplaceAllString(req.URL.Path, "/:id"), nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	gql := struct {
+		Query string `json:"query"`
+	}{}
+	if json.Unmarshal(body, &gql) != nil {
+		return unknownOperation, nil
+	}
+	return GraphQLOperation(gql.Query), nil
+}
+
+// GraphQLOperation names a NerdGraph query after the innermost field taking
+// arguments along the first path of its selection set, e.g. policy for
+// actor { account(id: 1) { alerts { policy(id: 2) { id } } } }, or after its
+// root field when no field takes arguments.
+func GraphQLOperation(query string) string {
+	i := strings.IndexByte(query, '{')
+	if i < 0 {
+		return unknownOperation
+	}
+	rest := query[i+1:]
+	root, operation := "", ""
+	for {
+		var name string
+		name, rest = graphQLName(rest)
+		// An alias is followed by the name of its field
+		if strings.HasPrefix(rest, ":") {
+			name, rest = graphQLName(rest[1:])
+		}
+		if name == "" {
+			break
+		}
+		if root == "" {
+			root = name
+		}
+		if strings.HasPrefix(rest, "(") {
+			operation = name
+			rest = strings.TrimLeft(skipArguments(rest), " \t\r\n,")
+		}
+		if !strings.HasPrefix(rest, "{") {
+			break
+		}
+		rest = rest[1:]
+	}
+	switch {
+	case operation != "":
+		return operation
+	case root != "":
+		return root
+	}
+	return unknownOperation
+}
+
+// graphQLName reads a name and the blanks after it
+func graphQLName(s string) (string, string) {
+	s = strings.TrimLeft(s, " \t\r\n,")
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	if end < 0 {
+		end = len(s)
+	}
+	return s[:end], strings.TrimLeft(s[end:], " \t\r\n,")
+}
+
+// skipArguments returns what follows the parenthesized arguments s starts with
+func skipArguments(s string) string {
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s[i+1:]
+			}
+		}
+	}
+	return ""
+}
+
+// Outcome classifies a response by its HTTP status or, for NerdGraph, which
+// answers most errors with HTTP 200, by the error classes of its errors. The
+// body of the response is buffered so that it can still be read.
+func Outcome(resp *http.Response) (string, error) {
+	if resp.StatusCode >= http.StatusBadRequest {
+		return string(nrerrors.ReasonForStatusCode(resp.StatusCode)), nil
+	}
+	if resp.Body == nil {
+		return OutcomeSuccess, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	gql := struct {
+		Errors []struct {
+			Message    string `json:"message"`
+			Extensions struct {
+				ErrorClass string `json:"errorClass"`
+				Code       string `json:"code"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}{}
+	if json.Unmarshal(body, &gql) != nil {
+		// Not a NerdGraph response
+		return OutcomeSuccess, nil
+	}
+	outcome := OutcomeSuccess
+	for _, e := range gql.Errors {
+		if strings.HasPrefix(e.Message, deprecationPrefix) {
+			continue
+		}
+		// The alerts client returns these errors as missing resources
+		if e.Message == alertsNotFound {
+			return string(nrerrors.ReasonNotFound), nil
+		}
+		outcome = string(nrerrors.ReasonUnknown)
+		for _, class := range []string{e.Extensions.ErrorClass, e.Extensions.Code} {
+			if r := nrerrors.ReasonForErrorClass(class); r != nrerrors.ReasonUnknown {
+				return string(r), nil
+			}
+		}
+	}
+	return outcome, nil
+}
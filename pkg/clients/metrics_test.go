@@ -0,0 +1,209 @@
+package nr
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestGraphQLOperation(t *testing.T) {
+	cases := map[string]struct {
+		query string
+		want  string
+	}{
+		"Query": {
+			query: `query($accountID: Int!, $id: ID!) { actor { account(id: $accountID) { alerts { policy(id: $id) { id name } } } } }`,
+			want:  "policy",
+		},
+		"Mutation": {
+			query: `mutation($accountId: Int!, $policy: AlertsPolicyInput!) { alertsPolicyCreate(accountId: $accountId, policy: $policy) { id } }`,
+			want:  "alertsPolicyCreate",
+		},
+		"NestedArguments": {
+			query: `query($guid: EntityGuid!) { actor { entity(guid: $guid) { ... on DashboardEntity { name } } } }`,
+			want:  "entity",
+		},
+		"Alias": {
+			query: `{ actor { account(id: 1) { search: nrqlConditionsSearch(searchCriteria: {name: "a"}) { nrqlConditions { id } } } } }`,
+			want:  "nrqlConditionsSearch",
+		},
+		"NoArguments": {
+			query: `{ actor { user { name } } }`,
+			want:  "actor",
+		},
+		"NotAQuery": {
+			query: "",
+			want:  unknownOperation,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GraphQLOperation(tc.query)); diff != "" {
+				t.Errorf("GraphQLOperation(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		body   string
+		want   string
+	}{
+		"Success": {
+			status: http.StatusOK,
+			body:   `{"data":{"actor":{"account":{"name":"test"}}}}`,
+			want:   OutcomeSuccess,
+		},
+		"Deprecated": {
+			status: http.StatusOK,
+			body:   `{"data":{},"errors":[{"message":"This field is deprecated! Use another one."}]}`,
+			want:   OutcomeSuccess,
+		},
+		"NerdGraphNotFound": {
+			status: http.StatusOK,
+			body:   `{"errors":[{"message":"Not Found","extensions":{"errorClass":"BAD_USER_INPUT"}}]}`,
+			want:   "NotFound",
+		},
+		"NerdGraphTooManyRequests": {
+			status: http.StatusOK,
+			body:   `{"errors":[{"message":"Too many requests","extensions":{"errorClass":"TOO_MANY_REQUESTS"}}]}`,
+			want:   "RateLimited",
+		},
+		"NerdGraphUnrelatedNotFound": {
+			status: http.StatusOK,
+			body:   `{"errors":[{"message":"Policy with ID 404 not found"}]}`,
+			want:   "Unknown",
+		},
+		"Unauthorized": {
+			status: http.StatusUnauthorized,
+			want:   "Unauthorized",
+		},
+		"ServerError": {
+			status: http.StatusServiceUnavailable,
+			body:   "oops",
+			want:   "Transient",
+		},
+		"REST": {
+			status: http.StatusOK,
+			body:   "not json",
+			want:   OutcomeSuccess,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.status, Body: io.NopCloser(strings.NewReader(tc.body))}
+			got, err := Outcome(resp)
+			if err != nil {
+				t.Fatalf("Outcome(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Outcome(...): -want, +got:\n%s\n", diff)
+			}
+			if tc.status < http.StatusBadRequest {
+				body, _ := io.ReadAll(resp.Body)
+				if diff := cmp.Diff(tc.body, string(body)); diff != "" {
+					t.Errorf("Outcome(...): -want body, +got body:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestInstrumentedTransport(t *testing.T) {
+	var received string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		if r.URL.Path == "/v2/alerts_policies/42.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"alertsPolicyCreate":{"id":"1"}}}`))
+	}))
+	defer srv.Close()
+
+	m := NewAPIMetrics()
+	c := &http.Client{Transport: m.Transport("1234567", http.DefaultTransport)}
+
+	query := `{"query":"mutation { alertsPolicyCreate(accountId: 1, policy: {name: \"a\"}) { id } }"}`
+	req, _ := http.NewRequestWithContext(WithResourceKind(context.Background(), "AlertsPolicy"), http.MethodPost, srv.URL+"/graphql", strings.NewReader(query))
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("c.Do(...): %v", err)
+	}
+	_ = resp.Body.Close()
+	if diff := cmp.Diff(query, received); diff != "" {
+		t.Errorf("c.Do(...): -want body, +got body:\n%s\n", diff)
+	}
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("alertsPolicyCreate", "AlertsPolicy", "1234567", OutcomeSuccess)); got != 1 {
+		t.Errorf("requests: want 1, got %v", got)
+	}
+
+	resp, err = c.Get(srv.URL + "/v2/alerts_policies/42.json")
+	if err != nil {
+		t.Fatalf("c.Get(...): %v", err)
+	}
+	_ = resp.Body.Close()
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("GET /v2/alerts_policies/:id.json", unknownKind, "1234567", "NotFound")); got != 1 {
+		t.Errorf("requests: want 1, got %v", got)
+	}
+	if got := testutil.CollectAndCount(m.duration); got != 2 {
+		t.Errorf("durations: want 2 series, got %v", got)
+	}
+}
+
+func TestInstrumentConnecter(t *testing.T) {
+	var kinds []string
+	record := func(ctx context.Context) { kinds = append(kinds, ResourceKind(ctx)) }
+	c := InstrumentConnecter("Dashboard", managed.ExternalConnectorFn(func(ctx context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		record(ctx)
+		return managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				record(ctx)
+				return managed.ExternalObservation{}, nil
+			},
+			CreateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+				record(ctx)
+				return managed.ExternalCreation{}, nil
+			},
+			UpdateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+				record(ctx)
+				return managed.ExternalUpdate{}, nil
+			},
+			DeleteFn: func(ctx context.Context, _ resource.Managed) error {
+				record(ctx)
+				return nil
+			},
+		}, nil
+	}))
+
+	ctx := context.Background()
+	ext, err := c.Connect(ctx, nil)
+	if err != nil {
+		t.Fatalf("Connect(...): %v", err)
+	}
+	_, _ = ext.Observe(ctx, nil)
+	_, _ = ext.Create(ctx, nil)
+	_, _ = ext.Update(ctx, nil)
+	_ = ext.Delete(ctx, nil)
+
+	want := []string{"Dashboard", "Dashboard", "Dashboard", "Dashboard", "Dashboard"}
+	if diff := cmp.Diff(want, kinds); diff != "" {
+		t.Errorf("InstrumentConnecter(...): -want kinds, +got kinds:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(unknownKind, ResourceKind(ctx)); diff != "" {
+		t.Errorf("ResourceKind(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -2,9 +2,14 @@ package nr
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/config"
@@ -16,8 +21,14 @@ import (
 )
 
 const (
-	errGetCreds     = "cannot get credentials"
-	errGetAccountID = "cannot get accountId from ProviderConfig"
+	errGetCreds          = "cannot get credentials"
+	errGetAccountID      = "cannot get accountId from ProviderConfig"
+	errAccountNotAllowed = "accountId %d is not allowed by ProviderConfig %q"
+	errParseAccountIDs   = "cannot parse account_ids entry %q"
+	errParseEndpoint     = "cannot parse endpoint URL"
+	errParseProxy        = "cannot parse proxy URL"
+	errGetCABundle       = "cannot get CA bundle Secret"
+	errParseCABundle     = "cannot parse CA bundle: no PEM encoded certificates found"
 )
 
 // ExtractNewRelicAccountID gets the accountID from the provider config
@@ -30,24 +41,152 @@ func ExtractNewRelicAccountID(pc *apisv1alpha1.ProviderConfig) (account int, err
 	return account, nil
 }
 
-func ExtractNewRelicCredentials(ctx context.Context, kube client.Client, pc *apisv1alpha1.ProviderConfig) (client *newrelic.NewRelic, err error) {
-	cd := pc.Spec.Credentials
-	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, kube, cd.CommonCredentialSelectors)
+// ResolveAccountID gets the account a managed resource targets: its accountId
+// override, which must be allowed by the provider config, or the default account
+func ResolveAccountID(pc *apisv1alpha1.ProviderConfig, override *int) (int, error) {
+	account, err := ExtractNewRelicAccountID(pc)
+	if err != nil || override == nil || *override == account {
+		return account, err
+	}
+	for _, id := range pc.Spec.AccountIDs {
+		if allowed, err := strconv.Atoi(id); err == nil && allowed == *override {
+			return allowed, nil
+		}
+	}
+	return 0, errors.Errorf(errAccountNotAllowed, *override, pc.GetName())
+}
+
+// AllowedAccountIDs gets the accounts managed resources may target: the
+// default account of the provider config, then its further accounts
+func AllowedAccountIDs(pc *apisv1alpha1.ProviderConfig) ([]int, error) {
+	account, err := ExtractNewRelicAccountID(pc)
+	if err != nil {
+		return nil, err
+	}
+	ids := []int{account}
+	seen := map[int]bool{account: true}
+	for _, id := range pc.Spec.AccountIDs {
+		allowed, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, errors.Wrapf(err, errParseAccountIDs, id)
+		}
+		if !seen[allowed] {
+			seen[allowed] = true
+			ids = append(ids, allowed)
+		}
+	}
+	return ids, nil
+}
+
+// ExtractNewRelicCredentials gets a client for the provider config whose
+// requests count against the account a managed resource targets, reusing the
+// cached one while the provider config and its credentials are unchanged
+func ExtractNewRelicCredentials(ctx context.Context, kube client.Client, pc *apisv1alpha1.ProviderConfig, accountID int) (client *newrelic.NewRelic, err error) {
+	return DefaultClientCache.Get(ctx, kube, pc, accountID)
+}
+
+// ClientOptions gets the client options for the endpoint overrides and HTTP
+// settings of the provider config. Requests are rate limited and reported per
+// account, which is the one the client targets.
+func ClientOptions(ctx context.Context, kube client.Client, pc *apisv1alpha1.ProviderConfig, accountID int) ([]newrelic.ConfigOption, error) {
+	var caBundle []byte
+	if pc.Spec.HTTP != nil && pc.Spec.HTTP.CABundleSecretRef != nil {
+		var err error
+		if caBundle, err = extractSecretKey(ctx, kube, *pc.Spec.HTTP.CABundleSecretRef); err != nil {
+			return nil, errors.Wrap(err, errGetCABundle)
+		}
+	}
+	return clientOptions(pc, accountID, caBundle)
+}
+
+// clientOptions gets the client options of a provider config with the CA
+// bundle its Secret holds, if it references one
+func clientOptions(pc *apisv1alpha1.ProviderConfig, accountID int, caBundle []byte) ([]newrelic.ConfigOption, error) {
+	var options []newrelic.ConfigOption
+	if ep := pc.Spec.Endpoints; ep != nil {
+		urls := []struct {
+			url    *string
+			option func(string) newrelic.ConfigOption
+		}{
+			{url: ep.NerdGraph, option: newrelic.ConfigNerdGraphBaseURL},
+			{url: ep.REST, option: newrelic.ConfigBaseURL},
+			{url: ep.Synthetics, option: newrelic.ConfigSyntheticsBaseURL},
+		}
+		for _, u := range urls {
+			if u.url == nil {
+				continue
+			}
+			if _, err := url.ParseRequestURI(*u.url); err != nil {
+				return nil, errors.Wrap(err, errParseEndpoint)
+			}
+			options = append(options, u.option(*u.url))
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg := pc.Spec.HTTP; cfg != nil {
+		if cfg.Timeout != nil {
+			options = append(options, newrelic.ConfigHTTPTimeout(cfg.Timeout.Duration))
+		}
+		if cfg.ProxyURL != nil {
+			proxy, err := url.Parse(*cfg.ProxyURL)
+			if err != nil {
+				return nil, errors.Wrap(err, errParseProxy)
+			}
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+		if cfg.CABundleSecretRef != nil {
+			pool, err := caCertPool(caBundle)
+			if err != nil {
+				return nil, err
+			}
+			transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		}
+	}
+
+	// Requests count against the budget of the account, shared by all controllers
+	account := strconv.Itoa(accountID)
+	return append(options, newrelic.ConfigHTTPTransport(DefaultRateLimiter.Transport(account, DefaultAPIMetrics.Transport(account, transport)))), nil
+}
+
+// ExtractCABundle gets the system certificate pool with the CA certificates of
+// a Secret key added
+func ExtractCABundle(ctx context.Context, kube client.Client, ref xpv1.SecretKeySelector) (*x509.CertPool, error) {
+	data, err := extractSecretKey(ctx, kube, ref)
 	if err != nil {
-		return nil, errors.Wrap(err, errGetCreds)
+		return nil, errors.Wrap(err, errGetCABundle)
 	}
+	return caCertPool(data)
+}
 
-	// Extract the region
-	region := pc.Spec.Region
+// caCertPool gets the system certificate pool with PEM encoded CA
+// certificates added
+func caCertPool(data []byte) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New(errParseCABundle)
+	}
+	return pool, nil
+}
 
-	// Create a client using "NEW_RELIC_API_KEY"
-	return GetNewRelicClient(strings.TrimSpace(string(data)), region)
+// ExtractNewRelicAPIKey gets the API key the provider config points to, for the APIs
+// that are not covered by the client
+func ExtractNewRelicAPIKey(ctx context.Context, kube client.Client, pc *apisv1alpha1.ProviderConfig) (string, error) {
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return "", errors.Wrap(err, errGetCreds)
+	}
+	return strings.TrimSpace(string(data)), nil
 }
 
 // GetNewRelicClient gets a new client
 // https://github.com/newrelic/newrelic-client-go
 // https://pkg.go.dev/github.com/newrelic/newrelic-client-go/v2/pkg/config@v2.23.0#ConfigOption
-func GetNewRelicClient(newRelicAPIKey string, region *string) (client *newrelic.NewRelic, err error) {
+func GetNewRelicClient(newRelicAPIKey string, region *string, opts ...newrelic.ConfigOption) (client *newrelic.NewRelic, err error) {
 
 	var options []newrelic.ConfigOption
 	options = append(options,
@@ -57,6 +196,8 @@ func GetNewRelicClient(newRelicAPIKey string, region *string) (client *newrelic.
 	if region != nil {
 		options = append(options, newrelic.ConfigRegion(*region))
 	}
+	// Add the overrides after the region, which resets the base URLs
+	options = append(options, opts...)
 
 	// Initialize the client.
 	client, err = newrelic.New(options...)
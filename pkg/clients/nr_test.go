@@ -0,0 +1,172 @@
+package nr
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+)
+
+func TestResolveAccountID(t *testing.T) {
+	pc := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			AccountID:  "1111111",
+			AccountIDs: []string{"2222222", "3333333"},
+		},
+	}
+
+	type want struct {
+		account int
+		err     error
+	}
+
+	cases := map[string]struct {
+		override *int
+		want     want
+	}{
+		"Default": {
+			want: want{account: 1111111},
+		},
+		"DefaultOverride": {
+			override: pointy.Int(1111111),
+			want:     want{account: 1111111},
+		},
+		"AllowedOverride": {
+			override: pointy.Int(3333333),
+			want:     want{account: 3333333},
+		},
+		"NotAllowedOverride": {
+			override: pointy.Int(4444444),
+			want:     want{err: errors.Errorf(errAccountNotAllowed, 4444444, "default")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResolveAccountID(pc, tc.override)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ResolveAccountID(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.account, got); diff != "" {
+				t.Errorf("ResolveAccountID(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAllowedAccountIDs(t *testing.T) {
+	type want struct {
+		accounts []int
+		err      error
+	}
+
+	cases := map[string]struct {
+		accountIDs []string
+		want       want
+	}{
+		"DefaultOnly": {
+			want: want{accounts: []int{1111111}},
+		},
+		"FurtherAccounts": {
+			accountIDs: []string{"2222222", "1111111", "3333333", "2222222"},
+			want:       want{accounts: []int{1111111, 2222222, 3333333}},
+		},
+		"InvalidAccount": {
+			accountIDs: []string{"staging"},
+			want:       want{err: errors.Wrapf(errors.New(`strconv.Atoi: parsing "staging": invalid syntax`), errParseAccountIDs, "staging")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{AccountID: "1111111", AccountIDs: tc.accountIDs}}
+			got, err := AllowedAccountIDs(pc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("AllowedAccountIDs(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.accounts, got); diff != "" {
+				t.Errorf("AllowedAccountIDs(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestClientOptions(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"actor":{"user":{"name":"test"}}}}`))
+	}))
+	defer srv.Close()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	type args struct {
+		ca       []byte
+		endpoint string
+	}
+
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"TrustedCABundle": {
+			args: args{ca: ca, endpoint: srv.URL},
+		},
+		"InvalidCABundle": {
+			args: args{ca: []byte("not a certificate"), endpoint: srv.URL},
+			want: errors.New(errParseCABundle),
+		},
+		"InvalidEndpoint": {
+			args: args{ca: ca, endpoint: "api.newrelic.com"},
+			want: errors.Wrap(errors.New(`parse "api.newrelic.com": invalid URI for request`), errParseEndpoint),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"ca.crt": tc.args.ca}
+					return nil
+				},
+			}
+			pc := &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{
+				Endpoints: &apisv1alpha1.ProviderEndpoints{NerdGraph: pointy.String(tc.args.endpoint)},
+				HTTP: &apisv1alpha1.ProviderHTTPConfig{
+					CABundleSecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "ca"},
+						Key:             "ca.crt",
+					},
+					Timeout: &metav1.Duration{Duration: 5 * time.Second},
+				},
+			}}
+			opts, err := ClientOptions(context.Background(), kube, pc, 1)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("ClientOptions(...): -want error, +got error:\n%s\n", diff)
+			}
+			if err != nil {
+				return
+			}
+			c, err := GetNewRelicClient("NRAK-TEST", pointy.String("EU"), opts...)
+			if err != nil {
+				t.Fatalf("GetNewRelicClient(...): %v", err)
+			}
+			resp := struct{}{}
+			if err := c.NerdGraph.QueryWithResponse("{ actor { user { name } } }", nil, &resp); err != nil {
+				t.Errorf("NerdGraph query through the overridden endpoint: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+// Package nrerrors classifies the errors of the New Relic NerdGraph and REST
+// APIs, so that controllers do not need to match on their messages.
+package nrerrors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	nrerrs "github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+)
+
+// A Reason is the class of a New Relic API error.
+type Reason string
+
+// Reasons of New Relic API errors.
+const (
+	ReasonNotFound     Reason = "NotFound"
+	ReasonUnauthorized Reason = "Unauthorized"
+	ReasonValidation   Reason = "Validation"
+	ReasonRateLimited  Reason = "RateLimited"
+	ReasonTransient    Reason = "Transient"
+	ReasonUnknown      Reason = "Unknown"
+)
+
+// An Error is a New Relic API error with its reason.
+type Error struct {
+	Reason Reason
+	Err    error
+}
+
+// Error returns the message of the API error prefixed by its reason, so that
+// the conditions and events of managed resources show the reason.
+func (e *Error) Error() string {
+	return string(e.Reason) + ": " + e.Err.Error()
+}
+
+// Unwrap returns the API error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Classify returns err as an *Error with its reason, or nil for a nil error.
+func Classify(err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	return &Error{Reason: ReasonFor(err), Err: err}
+}
+
+// errorClasses are the reasons of the NerdGraph error classes, reported in
+// the extensions of GraphQL errors and as the types of mutation errors.
+// NerdGraph answers most errors with HTTP 200, so they are the only reliable
+// reason of its errors.
+var errorClasses = map[string]Reason{
+	"NOT_FOUND":           ReasonNotFound,
+	"DASHBOARD_NOT_FOUND": ReasonNotFound,
+	"PAGE_NOT_FOUND":      ReasonNotFound,
+	"WIDGET_NOT_FOUND":    ReasonNotFound,
+
+	"FORBIDDEN":           ReasonUnauthorized,
+	"FORBIDDEN_OPERATION": ReasonUnauthorized,
+	"UNAUTHORIZED":        ReasonUnauthorized,
+	"UNAUTHENTICATED":     ReasonUnauthorized,
+	"ACCESS_DENIED":       ReasonUnauthorized,
+
+	"BAD_USER_INPUT":            ReasonValidation,
+	"INVALID_INPUT":             ReasonValidation,
+	"INVALID_PARAMETER":         ReasonValidation,
+	"VALIDATION_ERROR":          ReasonValidation,
+	"GRAPHQL_VALIDATION_FAILED": ReasonValidation,
+
+	"TOO_MANY_REQUESTS": ReasonRateLimited,
+
+	"SERVER_ERROR":          ReasonTransient,
+	"INTERNAL_SERVER_ERROR": ReasonTransient,
+	"SERVICE_UNAVAILABLE":   ReasonTransient,
+	"TIMEOUT":               ReasonTransient,
+}
+
+// graphQLError is implemented by the GraphQL error responses of the client,
+// which are not exported.
+type graphQLError interface {
+	error
+	IsRetryableError() bool
+}
+
+// graphQLErrors are the error classes of a GraphQL error response. The
+// alerts API still reports some of them as the code.
+type graphQLErrors struct {
+	Errors []struct {
+		Extensions struct {
+			ErrorClass string `json:"errorClass"`
+			Code       string `json:"code"`
+		} `json:"extensions"`
+	} `json:"errors"`
+}
+
+// New returns the error of a NerdGraph mutation, classified by its type.
+func New(class, message string) error {
+	return &Error{Reason: ReasonForErrorClass(class), Err: errors.New(message)}
+}
+
+// ReasonFor returns the reason of an error from the error types of the client,
+// HTTP status codes and NerdGraph error classes. Messages are never matched, as
+// they are free text that may mention other resources.
+func ReasonFor(err error) Reason {
+	if err == nil {
+		return ""
+	}
+
+	var (
+		classified *Error
+		notFound   *nrerrs.NotFound
+		unauth     *nrerrs.UnauthorizedError
+		payment    *nrerrs.PaymentRequiredError
+		invalid    *nrerrs.InvalidInput
+		retries    *nrerrs.MaxRetriesReached
+		statusCode *nrerrs.UnexpectedStatusCode
+		gqlErr     graphQLError
+		netErr     net.Error
+	)
+	switch {
+	case errors.As(err, &classified):
+		return classified.Reason
+	case errors.As(err, &notFound):
+		return ReasonNotFound
+	case errors.As(err, &unauth), errors.As(err, &payment):
+		return ReasonUnauthorized
+	case errors.As(err, &invalid):
+		return ReasonValidation
+	case errors.As(err, &statusCode):
+		// The status code is only exposed in the message
+		var code int
+		if _, serr := fmt.Sscanf(statusCode.Error(), "%d response returned", &code); serr == nil {
+			if r := ReasonForStatusCode(code); r != ReasonUnknown {
+				return r
+			}
+		}
+	case errors.Is(err, context.DeadlineExceeded):
+		return ReasonTransient
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ReasonTransient
+	case errors.As(err, &retries):
+		return ReasonTransient
+	case errors.As(err, &gqlErr):
+		return reasonForGraphQLError(gqlErr)
+	}
+	// The client returns the error class of throttled NerdGraph requests
+	if r, ok := errorClasses[err.Error()]; ok {
+		return r
+	}
+	return ReasonUnknown
+}
+
+// ReasonForErrorClass returns the reason of a NerdGraph error class.
+func ReasonForErrorClass(class string) Reason {
+	if r, ok := errorClasses[strings.ToUpper(class)]; ok {
+		return r
+	}
+	return ReasonUnknown
+}
+
+// reasonForGraphQLError returns the reason of the first error of a GraphQL
+// error response that has a known error class.
+func reasonForGraphQLError(err graphQLError) Reason {
+	b, jerr := json.Marshal(err)
+	if jerr != nil {
+		return ReasonUnknown
+	}
+	gql := graphQLErrors{}
+	if jerr := json.Unmarshal(b, &gql); jerr != nil {
+		return ReasonUnknown
+	}
+	for _, e := range gql.Errors {
+		for _, class := range []string{e.Extensions.ErrorClass, e.Extensions.Code} {
+			if r := ReasonForErrorClass(class); r != ReasonUnknown {
+				return r
+			}
+		}
+	}
+	return ReasonUnknown
+}
+
+// ReasonForStatusCode returns the reason of an HTTP error status code, as
+// returned by the REST APIs.
+func ReasonForStatusCode(code int) Reason {
+	switch {
+	case code == http.StatusNotFound, code == http.StatusGone:
+		return ReasonNotFound
+	case code == http.StatusUnauthorized, code == http.StatusForbidden, code == http.StatusPaymentRequired:
+		return ReasonUnauthorized
+	case code == http.StatusBadRequest, code == http.StatusConflict, code == http.StatusUnprocessableEntity:
+		return ReasonValidation
+	case code == http.StatusTooManyRequests:
+		return ReasonRateLimited
+	case code >= http.StatusInternalServerError, code == http.StatusRequestTimeout:
+		return ReasonTransient
+	}
+	return ReasonUnknown
+}
+
+// IsNotFound returns whether an error means the resource does not exist.
+func IsNotFound(err error) bool {
+	return ReasonFor(err) == ReasonNotFound
+}
+
+// IsUnauthorized returns whether an error means the credentials were rejected
+// or lack a permission.
+func IsUnauthorized(err error) bool {
+	return ReasonFor(err) == ReasonUnauthorized
+}
+
+// IsValidation returns whether an error means the request was invalid.
+func IsValidation(err error) bool {
+	return ReasonFor(err) == ReasonValidation
+}
+
+// IsRateLimited returns whether an error means the request was throttled.
+func IsRateLimited(err error) bool {
+	return ReasonFor(err) == ReasonRateLimited
+}
+
+// IsTransient returns whether an error may go away when the request is retried,
+// including rate limits.
+func IsTransient(err error) bool {
+	r := ReasonFor(err)
+	return r == ReasonTransient || r == ReasonRateLimited
+}
+
+// IgnoreNotFound returns nil when an error means the resource does not exist,
+// or the classified error otherwise.
+func IgnoreNotFound(err error) error {
+	if IsNotFound(err) {
+		return nil
+	}
+	return Classify(err)
+}
@@ -0,0 +1,221 @@
+package nrerrors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	nrerrs "github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/pkg/errors"
+)
+
+func TestReasonFor(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want Reason
+	}{
+		"Nil": {
+			err:  nil,
+			want: "",
+		},
+		"ClientNotFound": {
+			err:  nrerrs.NewNotFound("resource not found"),
+			want: ReasonNotFound,
+		},
+		"WrappedClientNotFound": {
+			err:  errors.Wrap(&nrerrs.NotFound{}, "cannot get policy"),
+			want: ReasonNotFound,
+		},
+		"NerdGraphNotFound": {
+			err:  nerdGraphError("NOT_FOUND", "", "The requested condition could not be located"),
+			want: ReasonNotFound,
+		},
+		"UnrelatedNotFoundMessage": {
+			err:  errors.New("Policy with ID 123456 not found"),
+			want: ReasonUnknown,
+		},
+		"NerdGraphUnrelatedNotFoundMessage": {
+			err:  nerdGraphError("BAD_USER_INPUT", "", "Policy with ID 123456 not found"),
+			want: ReasonValidation,
+		},
+		"ClientUnauthorized": {
+			err:  nrerrs.NewUnauthorizedError(),
+			want: ReasonUnauthorized,
+		},
+		"PaymentRequired": {
+			err:  nrerrs.NewPaymentRequiredError(),
+			want: ReasonUnauthorized,
+		},
+		"NerdGraphForbidden": {
+			err:  nerdGraphError("FORBIDDEN", "", "Access denied"),
+			want: ReasonUnauthorized,
+		},
+		"ClientInvalidInput": {
+			err:  nrerrs.NewInvalidInput("name is required"),
+			want: ReasonValidation,
+		},
+		"NerdGraphBadUserInputCode": {
+			err:  nerdGraphError("", "BAD_USER_INPUT", "Argument \"policyId\" has invalid value"),
+			want: ReasonValidation,
+		},
+		"NerdGraphUnknownClass": {
+			err:  nerdGraphError("SOMETHING_NEW", "", "Validation Error: threshold must be greater than 0"),
+			want: ReasonUnknown,
+		},
+		"NerdGraphTooManyRequests": {
+			err:  nerdGraphError("TOO_MANY_REQUESTS", "", "Too many requests"),
+			want: ReasonRateLimited,
+		},
+		"ThrottledRequest": {
+			err:  errors.New("TOO_MANY_REQUESTS"),
+			want: ReasonRateLimited,
+		},
+		"MaxRetries": {
+			err:  nrerrs.NewMaxRetriesReached("503 response returned"),
+			want: ReasonTransient,
+		},
+		"NerdGraphServerError": {
+			err:  nerdGraphError("SERVER_ERROR", "", "Internal server error"),
+			want: ReasonTransient,
+		},
+		"DeadlineExceeded": {
+			err:  errors.Wrap(context.DeadlineExceeded, "cannot query NerdGraph"),
+			want: ReasonTransient,
+		},
+		"StatusNotFound": {
+			err:  nrerrs.NewUnexpectedStatusCode(http.StatusNotFound, ""),
+			want: ReasonNotFound,
+		},
+		"StatusForbidden": {
+			err:  nrerrs.NewUnexpectedStatusCode(http.StatusForbidden, "no access"),
+			want: ReasonUnauthorized,
+		},
+		"StatusUnprocessableEntity": {
+			err:  nrerrs.NewUnexpectedStatusCode(http.StatusUnprocessableEntity, ""),
+			want: ReasonValidation,
+		},
+		"StatusTooManyRequests": {
+			err:  nrerrs.NewUnexpectedStatusCode(http.StatusTooManyRequests, ""),
+			want: ReasonRateLimited,
+		},
+		"StatusBadGateway": {
+			err:  nrerrs.NewUnexpectedStatusCode(http.StatusBadGateway, ""),
+			want: ReasonTransient,
+		},
+		"Classified": {
+			err:  errors.Wrap(&Error{Reason: ReasonValidation, Err: errors.New("bad table")}, "cannot upload"),
+			want: ReasonValidation,
+		},
+		"Unknown": {
+			err:  errors.New("something unexpected happened"),
+			want: ReasonUnknown,
+		},
+		"UnknownNotFoundMessage": {
+			err:  errors.New("404 Not Found"),
+			want: ReasonUnknown,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ReasonFor(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ReasonFor(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestIgnoreNotFound(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"NotFound": {
+			err:  nrerrs.NewNotFound("resource not found"),
+			want: nil,
+		},
+		"Other": {
+			err:  errBoom,
+			want: &Error{Reason: ReasonUnknown, Err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IgnoreNotFound(tc.err)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("IgnoreNotFound(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestClassify(t *testing.T) {
+	err := nrerrs.NewNotFound("entity not found")
+	got := Classify(err)
+
+	var e *Error
+	if !errors.As(got, &e) {
+		t.Fatalf("Classify(...): want *Error, got %T", got)
+	}
+	if diff := cmp.Diff(ReasonNotFound, e.Reason); diff != "" {
+		t.Errorf("Classify(...): -want reason, +got reason:\n%s\n", diff)
+	}
+	if diff := cmp.Diff("NotFound: entity not found", got.Error()); diff != "" {
+		t.Errorf("Classify(...): -want message, +got message:\n%s\n", diff)
+	}
+	if Classify(got) != got {
+		t.Errorf("Classify(...): want a classified error to be kept")
+	}
+}
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		class string
+		want  Reason
+	}{
+		"NotFound": {
+			class: "DASHBOARD_NOT_FOUND",
+			want:  ReasonNotFound,
+		},
+		"Forbidden": {
+			class: "FORBIDDEN_OPERATION",
+			want:  ReasonUnauthorized,
+		},
+		"LowerCase": {
+			class: "invalid_input",
+			want:  ReasonValidation,
+		},
+		"Unknown": {
+			class: "FAILURE",
+			want:  ReasonUnknown,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ReasonFor(New(tc.class, "the dashboard could not be changed"))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ReasonFor(New(...)): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+// nerdGraphError returns a GraphQL error response of the alerts API
+func nerdGraphError(class, code, message string) error {
+	e := &alerts.GraphQLErrorResponse{}
+	body := fmt.Sprintf(`{"errors":[{"message":%q,"extensions":{"errorClass":%q,"code":%q}}]}`, message, class, code)
+	if err := json.Unmarshal([]byte(body), e); err != nil {
+		panic(err)
+	}
+	return e
+}
@@ -0,0 +1,264 @@
+package nr
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// errorClassTooManyRequests is the NerdGraph error class of throttled
+	// requests, which are answered with HTTP 200
+	errorClassTooManyRequests = "TOO_MANY_REQUESTS"
+
+	minThrottleBackoff = time.Second
+	maxThrottleBackoff = time.Minute
+)
+
+// DefaultRateLimiter is the rate limiter shared by all controllers
+var DefaultRateLimiter = NewRateLimiter(0)
+
+// A RateLimiter enforces a per-account budget of requests per minute and backs
+// off all requests of an account once New Relic throttles one of them.
+type RateLimiter struct {
+	mu       sync.Mutex
+	rpm      int
+	accounts map[string]*accountLimit
+
+	throttled *prometheus.CounterVec
+	backoff   *prometheus.GaugeVec
+	waited    *prometheus.CounterVec
+}
+
+type accountLimit struct {
+	limiter      *rate.Limiter
+	backoffUntil time.Time
+	failures     int
+}
+
+// NewRateLimiter returns a rate limiter allowing rpm requests per minute and
+// account. Zero disables the budget but keeps backing off throttled accounts.
+func NewRateLimiter(rpm int) *RateLimiter {
+	return &RateLimiter{
+		rpm:      rpm,
+		accounts: map[string]*accountLimit{},
+		throttled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "provider_newrelic_throttled_requests_total",
+			Help: "Requests New Relic rejected as rate limited, by account.",
+		}, []string{"account"}),
+		backoff: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "provider_newrelic_throttle_backoff_seconds",
+			Help: "Backoff applied to all requests of an account after it was last throttled, zero once a request succeeds again.",
+		}, []string{"account"}),
+		waited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "provider_newrelic_rate_limit_wait_seconds_total",
+			Help: "Time requests waited for the request budget or a throttling backoff, by account.",
+		}, []string{"account"}),
+	}
+}
+
+// SetRequestsPerMinute changes the per-account budget of requests per minute
+func (r *RateLimiter) SetRequestsPerMinute(rpm int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rpm = rpm
+	for _, a := range r.accounts {
+		a.limiter.SetLimit(r.limit())
+		a.limiter.SetBurst(r.burst())
+	}
+}
+
+// Transport wraps an HTTP transport so that its requests count against the
+// budget of an account
+func (r *RateLimiter) Transport(account string, next http.RoundTripper) http.RoundTripper {
+	return &rateLimitedTransport{limiter: r, account: account, next: next}
+}
+
+// Describe implements prometheus.Collector
+func (r *RateLimiter) Describe(ch chan<- *prometheus.Desc) {
+	r.throttled.Describe(ch)
+	r.backoff.Describe(ch)
+	r.waited.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (r *RateLimiter) Collect(ch chan<- prometheus.Metric) {
+	r.throttled.Collect(ch)
+	r.backoff.Collect(ch)
+	r.waited.Collect(ch)
+}
+
+func (r *RateLimiter) limit() rate.Limit {
+	if r.rpm <= 0 {
+		return rate.Inf
+	}
+	return rate.Limit(float64(r.rpm) / 60)
+}
+
+func (r *RateLimiter) burst() int {
+	// Allow a second worth of requests at once
+	if b := r.rpm / 60; b > 1 {
+		return b
+	}
+	return 1
+}
+
+func (r *RateLimiter) account(name string) *accountLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, ok := r.accounts[name]
+	if !ok {
+		a = &accountLimit{limiter: rate.NewLimiter(r.limit(), r.burst())}
+		r.accounts[name] = a
+	}
+	return a
+}
+
+// delay returns how long the account is still backing off
+func (r *RateLimiter) delay(a *accountLimit) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return time.Until(a.backoffUntil)
+}
+
+// throttle backs off the account exponentially with jitter, or for as long as
+// the response asks
+func (r *RateLimiter) throttle(name string, a *accountLimit, resp *http.Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a.failures++
+	wait := RetryAfter(resp)
+	if wait <= 0 {
+		wait = Backoff(a.failures)
+	}
+	a.backoffUntil = time.Now().Add(wait)
+	r.throttled.WithLabelValues(name).Inc()
+	r.backoff.WithLabelValues(name).Set(wait.Seconds())
+}
+
+func (r *RateLimiter) recover(name string, a *accountLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if a.failures == 0 {
+		return
+	}
+	a.failures = 0
+	r.backoff.WithLabelValues(name).Set(0)
+}
+
+type rateLimitedTransport struct {
+	limiter *RateLimiter
+	account string
+	next    http.RoundTripper
+}
+
+// RoundTrip waits for the backoff of the account and its request budget, then
+// sends the request. A throttled response is returned as is, for the client to
+// retry it, but delays the next requests of the account.
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	a := t.limiter.account(t.account)
+	start := time.Now()
+	if d := t.limiter.delay(a); d > 0 {
+		timer := time.NewTimer(d)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+	if err := a.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	if waited := time.Since(start); waited > time.Millisecond {
+		t.limiter.waited.WithLabelValues(t.account).Add(waited.Seconds())
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	throttled, err := IsThrottled(resp)
+	if err != nil {
+		return nil, err
+	}
+	if throttled {
+		t.limiter.throttle(t.account, a, resp)
+	} else {
+		t.limiter.recover(t.account, a)
+	}
+	return resp, nil
+}
+
+// IsThrottled reports whether New Relic rejected a request as rate limited,
+// either with HTTP 429 or, for NerdGraph, a TOO_MANY_REQUESTS error. The body
+// of the response is buffered so that it can still be read.
+func IsThrottled(resp *http.Response) (bool, error) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+	if resp.StatusCode != http.StatusOK || resp.Body == nil {
+		return false, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil || !bytes.Contains(body, []byte(errorClassTooManyRequests)) {
+		return false, err
+	}
+	gql := struct {
+		Errors []struct {
+			Extensions struct {
+				ErrorClass string `json:"errorClass"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}{}
+	if json.Unmarshal(body, &gql) != nil {
+		// Not a NerdGraph response
+		return false, nil
+	}
+	for _, e := range gql.Errors {
+		if e.Extensions.ErrorClass == errorClassTooManyRequests {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RetryAfter returns the wait a response asks for in its Retry-After header,
+// in seconds or as an HTTP date
+func RetryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		return time.Duration(s) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// Backoff returns the exponential backoff after a number of consecutive
+// throttled requests, with up to 50% jitter so that controllers do not retry
+// in lockstep
+func Backoff(failures int) time.Duration {
+	d := maxThrottleBackoff
+	if failures < 7 {
+		d = minThrottleBackoff << (failures - 1)
+	}
+	if d > maxThrottleBackoff {
+		d = maxThrottleBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1)) //nolint:gosec // jitter does not need a secure source
+}
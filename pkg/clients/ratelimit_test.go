@@ -0,0 +1,123 @@
+package nr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestIsThrottled(t *testing.T) {
+	type want struct {
+		throttled bool
+		body      string
+	}
+
+	cases := map[string]struct {
+		status int
+		body   string
+		want   want
+	}{
+		"TooManyRequests": {
+			status: http.StatusTooManyRequests,
+			want:   want{throttled: true},
+		},
+		"NerdGraphTooManyRequests": {
+			status: http.StatusOK,
+			body:   `{"errors":[{"message":"Too many requests","extensions":{"errorClass":"TOO_MANY_REQUESTS"}}]}`,
+			want:   want{throttled: true, body: `{"errors":[{"message":"Too many requests","extensions":{"errorClass":"TOO_MANY_REQUESTS"}}]}`},
+		},
+		"NerdGraphData": {
+			status: http.StatusOK,
+			body:   `{"data":{"actor":{"account":{"name":"TOO_MANY_REQUESTS"}}}}`,
+			want:   want{body: `{"data":{"actor":{"account":{"name":"TOO_MANY_REQUESTS"}}}}`},
+		},
+		"ServerError": {
+			status: http.StatusInternalServerError,
+			body:   "oops",
+			want:   want{body: "oops"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.status, Body: io.NopCloser(strings.NewReader(tc.body))}
+			got, err := IsThrottled(resp)
+			if err != nil {
+				t.Fatalf("IsThrottled(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.throttled, got); diff != "" {
+				t.Errorf("IsThrottled(...): -want, +got:\n%s\n", diff)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			if diff := cmp.Diff(tc.want.body, string(body)); diff != "" {
+				t.Errorf("IsThrottled(...): -want body, +got body:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[string]struct {
+		failures int
+		min      time.Duration
+		max      time.Duration
+	}{
+		"First":  {failures: 1, min: 500 * time.Millisecond, max: time.Second},
+		"Third":  {failures: 3, min: 2 * time.Second, max: 4 * time.Second},
+		"Capped": {failures: 30, min: 30 * time.Second, max: time.Minute},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				if got := Backoff(tc.failures); got < tc.min || got > tc.max {
+					t.Errorf("Backoff(%d): want between %s and %s, got %s", tc.failures, tc.min, tc.max, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitedTransport(t *testing.T) {
+	throttle := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if throttle {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	r := NewRateLimiter(6000)
+	c := &http.Client{Transport: r.Transport("1234567", http.DefaultTransport)}
+
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("c.Get(...): %v", err)
+	}
+	_ = resp.Body.Close()
+	if got := testutil.ToFloat64(r.throttled.WithLabelValues("1234567")); got != 1 {
+		t.Errorf("throttled requests: want 1, got %v", got)
+	}
+	if got := testutil.ToFloat64(r.backoff.WithLabelValues("1234567")); got <= 0 {
+		t.Errorf("backoff: want > 0, got %v", got)
+	}
+
+	throttle = false
+	resp, err = c.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("c.Get(...): %v", err)
+	}
+	_ = resp.Body.Close()
+	if got := testutil.ToFloat64(r.backoff.WithLabelValues("1234567")); got != 0 {
+		t.Errorf("backoff after recovery: want 0, got %v", got)
+	}
+}
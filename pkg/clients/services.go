@@ -0,0 +1,50 @@
+package nr
+
+import (
+	"context"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+)
+
+// AlertsClient is the part of the alerts API used by the policy and NRQL
+// condition controllers
+type AlertsClient interface {
+	QueryPolicyWithContext(ctx context.Context, accountID int, id string) (*alerts.AlertsPolicy, error)
+	ListPoliciesWithContext(ctx context.Context, params *alerts.ListPoliciesParams) ([]alerts.Policy, error)
+	CreatePolicyMutationWithContext(ctx context.Context, accountID int, policy alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error)
+	UpdatePolicyMutationWithContext(ctx context.Context, accountID int, policyID string, policy alerts.AlertsPolicyUpdateInput) (*alerts.AlertsPolicy, error)
+	UpdatePolicyChannelsWithContext(ctx context.Context, policyID int, channelIDs []int) (*alerts.PolicyChannels, error)
+	DeletePolicyMutationWithContext(ctx context.Context, accountID int, id string) (*alerts.AlertsPolicy, error)
+
+	GetNrqlConditionQueryWithContext(ctx context.Context, accountID int, conditionID string) (*alerts.NrqlAlertCondition, error)
+	SearchNrqlConditionsQueryWithContext(ctx context.Context, accountID int, searchCriteria alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error)
+	CreateNrqlConditionStaticMutationWithContext(ctx context.Context, accountID int, policyID string, nrqlCondition alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error)
+	CreateNrqlConditionBaselineMutationWithContext(ctx context.Context, accountID int, policyID string, nrqlCondition alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error)
+	UpdateNrqlConditionStaticMutationWithContext(ctx context.Context, accountID int, conditionID string, nrqlCondition alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error)
+	UpdateNrqlConditionBaselineMutationWithContext(ctx context.Context, accountID int, conditionID string, nrqlCondition alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error)
+	DeleteNrqlConditionMutationWithContext(ctx context.Context, accountID int, conditionID string) (string, error)
+}
+
+// DashboardsClient is the part of the dashboards API used by the dashboard
+// controller
+type DashboardsClient interface {
+	GetDashboardEntityWithContext(ctx context.Context, guid common.EntityGUID) (*entities.DashboardEntity, error)
+	DashboardCreateWithContext(ctx context.Context, accountID int, dashboard dashboards.DashboardInput) (*dashboards.DashboardCreateResult, error)
+	DashboardUpdateWithContext(ctx context.Context, dashboard dashboards.DashboardInput, guid common.EntityGUID) (*dashboards.DashboardUpdateResult, error)
+	DashboardDeleteWithContext(ctx context.Context, guid common.EntityGUID) (*dashboards.DashboardDeleteResult, error)
+}
+
+// EntitiesClient is the part of the entities API used by the entity lookup
+// controller
+type EntitiesClient interface {
+	GetEntitySearchByQueryWithContext(ctx context.Context, options entities.EntitySearchOptions, query string, sortBy []entities.EntitySearchSortCriteria) (*entities.EntitySearch, error)
+}
+
+var (
+	_ AlertsClient     = &alerts.Alerts{}
+	_ DashboardsClient = &dashboards.Dashboards{}
+	_ EntitiesClient   = &entities.Entities{}
+)
@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/accountmanagement"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/account/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotAccount        = "managed resource is not an Account custom resource"
+	errTrackPCUsage      = "cannot track ProviderConfig usage"
+	errGetPC             = "cannot get ProviderConfig"
+	errWritePC           = "cannot write ProviderConfig"
+	errExternalNameNotID = "external name is not an account ID"
+)
+
+// providerConfigRegions maps account region codes to ProviderConfig regions
+var providerConfigRegions = map[string]string{
+	"us01": "US",
+	"eu01": "EU",
+}
+
+// Setup adds a controller that reconciles Account.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AccountGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.AccountKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AccountGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Account{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return nil, errors.New(errNotAccount)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ExtractNewRelicAccountID(pc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, pc: pc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client *newrelic.NewRelic
+	kube   client.Client
+	pc     *apisv1alpha1.ProviderConfig
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAccount)
+	}
+
+	account, err := c.GetAccountByIDOrName(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
+	}
+	if account == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update the status
+	cr.Status.AtProvider = v1alpha1.AccountObservation{
+		ID:         account.ID,
+		RegionCode: account.RegionCode,
+	}
+
+	if cr.Spec.WriteProviderConfigTo != nil {
+		if err := c.WriteProviderConfig(ctx, cr, *account); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errWritePC)
+		}
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  IsUpToDate(cr, *account),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAccount)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	input := accountmanagement.AccountManagementCreateInput{
+		Name:       cr.Spec.ForProvider.Name,
+		RegionCode: cr.Spec.ForProvider.RegionCode,
+	}
+	response, err := c.client.AccountManagement.AccountManagementCreateAccountWithContext(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
+	}
+
+	// The account ID is the identity of the account
+	meta.SetExternalName(cr, strconv.Itoa(response.ManagedAccount.ID))
+	cr.Status.AtProvider.ID = response.ManagedAccount.ID
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccount)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errExternalNameNotID)
+	}
+
+	input := accountmanagement.AccountManagementUpdateInput{
+		ID:   id,
+		Name: cr.Spec.ForProvider.Name,
+	}
+	_, err = c.client.AccountManagement.AccountManagementUpdateAccountWithContext(ctx, input)
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nrerrors.Classify(err)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return errors.New(errNotAccount)
+	}
+
+	// Accounts can't be deleted through the API, the account is left in place.
+	// A written ProviderConfig is garbage collected through its owner reference.
+	cr.Status.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// GetAccountByIDOrName returns the managed account identified by the external name. Without an
+// external name it falls back to an account with the same name, so existing accounts can be
+// adopted. Returns nil if no account was found.
+func (c *external) GetAccountByIDOrName(ctx context.Context, cr *v1alpha1.Account) (*accountmanagement.AccountManagementManagedAccount, error) {
+	id := 0
+	if name := meta.GetExternalName(cr); name != "" {
+		var err error
+		if id, err = strconv.Atoi(name); err != nil {
+			return nil, errors.New(errExternalNameNotID)
+		}
+	}
+
+	accounts, err := c.client.AccountManagement.GetManagedAccountsWithContext(ctx)
+	if err != nil {
+		return nil, nrerrors.Classify(err)
+	}
+
+	for _, account := range *accounts {
+		if id != 0 && account.ID == id {
+			return &account, nil
+		}
+		if id == 0 && account.Name == cr.Spec.ForProvider.Name {
+			meta.SetExternalName(cr, strconv.Itoa(account.ID))
+			_ = c.kube.Update(ctx, cr)
+			return &account, nil
+		}
+	}
+	return nil, nil
+}
+
+// WriteProviderConfig creates or updates a ProviderConfig for the account, using the
+// credentials of the ProviderConfig of the Account resource
+func (c *external) WriteProviderConfig(ctx context.Context, cr *v1alpha1.Account, account accountmanagement.AccountManagementManagedAccount) error {
+	pc := &apisv1alpha1.ProviderConfig{}
+	pc.SetName(cr.Spec.WriteProviderConfigTo.Name)
+	_, err := controllerutil.CreateOrUpdate(ctx, c.kube, pc, func() error {
+		pc.Spec = GenerateProviderConfigSpec(c.pc, account)
+		meta.AddOwnerReference(pc, meta.AsController(meta.TypedReferenceTo(cr, v1alpha1.AccountGroupVersionKind)))
+		return nil
+	})
+	return err
+}
+
+// GenerateProviderConfigSpec returns the spec of a ProviderConfig targeting the account with
+// the credentials of the given ProviderConfig
+func GenerateProviderConfigSpec(from *apisv1alpha1.ProviderConfig, account accountmanagement.AccountManagementManagedAccount) apisv1alpha1.ProviderConfigSpec {
+	spec := *from.Spec.DeepCopy()
+	spec.AccountID = strconv.Itoa(account.ID)
+	if region, ok := providerConfigRegions[account.RegionCode]; ok {
+		spec.Region = &region
+	}
+	return spec
+}
+
+// IsUpToDate determines whether the Account needs to be updated
+func IsUpToDate(p *v1alpha1.Account, account accountmanagement.AccountManagementManagedAccount) bool {
+	return p.Spec.ForProvider.Name == account.Name
+}
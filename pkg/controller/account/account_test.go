@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/accountmanagement"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/account/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+)
+
+type accountModifier func(*v1alpha1.Account)
+
+func account(m ...accountModifier) *v1alpha1.Account {
+	cr := &v1alpha1.Account{
+		Spec: v1alpha1.AccountSpec{
+			ForProvider: v1alpha1.AccountParameters{
+				Name:       "Payments",
+				RegionCode: "eu01",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.Account
+		nr accountmanagement.AccountManagementManagedAccount
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"DiffName": {
+			args: args{cr: *account(func(cr *v1alpha1.Account) {
+				cr.Spec.ForProvider.Name = "Payments EU"
+			}),
+				nr: accountmanagement.AccountManagementManagedAccount{ID: 4000123, Name: "Payments", RegionCode: "eu01"},
+			},
+			want: false,
+		},
+		"Same": {
+			args: args{cr: *account(),
+				nr: accountmanagement.AccountManagementManagedAccount{ID: 4000123, Name: "Payments", RegionCode: "eu01"},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(&tc.args.cr, tc.args.nr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateProviderConfigSpec(t *testing.T) {
+	from := &apisv1alpha1.ProviderConfig{
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			Credentials: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "newrelic-creds", Namespace: "crossplane-system"},
+						Key:             "credentials",
+					},
+				},
+			},
+			AccountID: "1234567",
+			Region:    pointy.String("US"),
+		},
+	}
+
+	got := GenerateProviderConfigSpec(from, accountmanagement.AccountManagementManagedAccount{ID: 4000123, Name: "Payments", RegionCode: "eu01"})
+
+	want := *from.Spec.DeepCopy()
+	want.AccountID = "4000123"
+	want.Region = pointy.String("EU")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.TestGenerateProviderConfigSpec(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff("1234567", from.Spec.AccountID); diff != "" {
+		t.Errorf("e.TestGenerateProviderConfigSpec(...): source ProviderConfig changed -want, +got:\n%s\n", diff)
+	}
+}
@@ -20,18 +20,17 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
-	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -41,6 +40,7 @@ import (
 	"github.com/crossplane-contrib/provider-newrelic/apis/alertspolicy/v1alpha1"
 	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
 	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
 	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
 )
 
@@ -50,26 +50,38 @@ const (
 	errGetPC        = "cannot get ProviderConfig"
 )
 
+// Reasons of the events recorded on managed resources
+const (
+	reasonIDChanged     event.Reason = "IDChanged"
+	reasonAdoptedByName event.Reason = "AdoptedByName"
+	reasonSkippedDelete event.Reason = "SkippedDelete"
+)
+
 // Setup adds a controller that reconciles AlertsPolicy.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.AlertsPolicyGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
 	}
 
+	log := o.Logger.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
-			kube:  mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
-		}),
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.AlertsPolicyKind, &connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			log:    log,
+			record: recorder,
+		})),
 		managed.WithConnectionPublishers(),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(log),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -92,8 +104,10 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
-	kube  client.Client
-	usage resource.Tracker
+	kube   client.Client
+	usage  resource.Tracker
+	log    logging.Logger
+	record event.Recorder
 }
 
 // Connect typically produces an ExternalClient by:
@@ -117,26 +131,28 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	// Get the account id from the provider config
-	accountID, err := nr.ExtractNewRelicAccountID(pc)
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create a client using NR Credentials
-	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc)
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+	return &external{alerts: &nrClient.Alerts, kube: c.kube, accountID: accountID, log: c.log.WithValues("name", cr.GetName()), record: c.record}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	client    *newrelic.NewRelic
+	alerts    nr.AlertsClient
 	kube      client.Client
 	accountID int
+	log       logging.Logger
+	record    event.Recorder
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -149,7 +165,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	policy, err := c.GetAlertsPolicyByIDOrName(ctx, cr)
 
 	if err != nil {
-		return managed.ExternalObservation{}, err
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
 	}
 
 	if policy.Name == "" {
@@ -169,7 +185,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  IsUpToDate(cr, *policy),
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: GenerateConnectionDetails(policy.ID),
 	}, nil
 }
 
@@ -185,9 +201,9 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		Name:               cr.Spec.ForProvider.Name,
 	}
 
-	response, err := c.client.Alerts.CreatePolicyMutationWithContext(ctx, c.accountID, input)
+	response, err := c.alerts.CreatePolicyMutationWithContext(ctx, c.accountID, input)
 	if err != nil {
-		return managed.ExternalCreation{}, err
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
 	}
 
 	// Set the ID
@@ -199,15 +215,22 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
-	_, err = c.client.Alerts.UpdatePolicyChannelsWithContext(ctx, policyID, cr.Spec.ForProvider.ChannelIDs)
+	_, err = c.alerts.UpdatePolicyChannelsWithContext(ctx, policyID, cr.Spec.ForProvider.ChannelIDs)
 	if err != nil {
-		return managed.ExternalCreation{}, err
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
 	}
 
 	cr.SetConditions(xpv1.Available())
 	cr.Status.AtProvider.DeepCopyInto(&cr.Status.AtProvider)
 
-	return managed.ExternalCreation{}, nil
+	return managed.ExternalCreation{ConnectionDetails: GenerateConnectionDetails(response.ID)}, nil
+}
+
+// GenerateConnectionDetails returns the connection details of a policy
+func GenerateConnectionDetails(id string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		"id": []byte(id),
+	}
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -221,9 +244,9 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		IncidentPreference: alerts.AlertsIncidentPreference(cr.Spec.ForProvider.IncidentPreference),
 		Name:               cr.Spec.ForProvider.Name,
 	}
-	_, err := c.client.Alerts.UpdatePolicyMutation(c.accountID, cr.Spec.ForProvider.ID, policy)
+	_, err := c.alerts.UpdatePolicyMutationWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID, policy)
 	if err != nil {
-		return managed.ExternalUpdate{}, err
+		return managed.ExternalUpdate{}, nrerrors.Classify(err)
 	}
 
 	// Update the channels it's associated with
@@ -232,9 +255,9 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, err
 	}
 	// ToDo: Convert to nerdgraph, once it's supported
-	_, err = c.client.Alerts.UpdatePolicyChannelsWithContext(ctx, policyID, cr.Spec.ForProvider.ChannelIDs)
+	_, err = c.alerts.UpdatePolicyChannelsWithContext(ctx, policyID, cr.Spec.ForProvider.ChannelIDs)
 	if err != nil {
-		return managed.ExternalUpdate{}, err
+		return managed.ExternalUpdate{}, nrerrors.Classify(err)
 	}
 
 	cr.SetConditions(xpv1.Available())
@@ -253,16 +276,13 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 	if cr.Spec.ForProvider.ID == "" {
-		fmt.Printf("skipping delete for policy %s: Id must be set", cr.Spec.ForProvider.Name)
+		c.log.Debug("Skipping delete of policy without an ID")
+		c.record.Event(cr, event.Normal(reasonSkippedDelete, "Skipped deleting the policy from New Relic because it has no ID"))
 		return nil
 	}
 
-	_, err := c.client.Alerts.DeletePolicyMutationWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID)
-	if err != nil {
-		return err
-	}
-
-	return err
+	_, err := c.alerts.DeletePolicyMutationWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID)
+	return nrerrors.IgnoreNotFound(err)
 }
 
 func (c *external) SetExternalNameIfNotSet(ctx context.Context, cr *v1alpha1.AlertsPolicy, response *alerts.AlertsPolicy) {
@@ -280,7 +300,7 @@ func (c *external) GetAlertsPolicyByIDOrName(ctx context.Context, cr *v1alpha1.A
 	defaultPolicy := &alerts.AlertsPolicy{}
 
 	// Get the policy by ID
-	policyByID, err := c.client.Alerts.QueryPolicyWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID)
+	policyByID, err := c.alerts.QueryPolicyWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID)
 
 	// return the policy if found
 	if err == nil {
@@ -289,34 +309,46 @@ func (c *external) GetAlertsPolicyByIDOrName(ctx context.Context, cr *v1alpha1.A
 
 	if err != nil {
 		// If not found, the ID may have changed - attempt to look up the new ID
-		if strings.Contains(err.Error(), "Not Found") {
+		if nrerrors.IsNotFound(err) {
 			policyID, err := c.GetAlertsPolicyIDByName(ctx, cr)
 			// try to look up the policy using the new ID
 			if policyID > 0 {
 				// return the policy if found
-				policyByID, err := c.client.Alerts.QueryPolicyWithContext(ctx, c.accountID, strconv.Itoa(policyID))
+				policyByID, err := c.alerts.QueryPolicyWithContext(ctx, c.accountID, strconv.Itoa(policyID))
 				if err == nil {
+					c.recordNewID(cr, policyByID.ID)
 					cr.Spec.ForProvider.ID = policyByID.ID
 					_ = c.kube.Update(ctx, cr)
-					fmt.Println("Updating policy " + policyByID.Name + " with new ID: " + policyByID.ID)
 					return policyByID, nil
 				}
 			}
-			return defaultPolicy, err
+			return defaultPolicy, nrerrors.Classify(err)
 		}
 	}
-	return defaultPolicy, nil
+	return defaultPolicy, nrerrors.Classify(err)
+}
+
+// recordNewID records that a policy found by name was adopted, when the managed
+// resource had no ID yet, or that its ID changed
+func (c *external) recordNewID(cr *v1alpha1.AlertsPolicy, id string) {
+	if cr.Spec.ForProvider.ID == "" {
+		c.log.Info("Adopted existing policy by name", "id", id)
+		c.record.Event(cr, event.Normal(reasonAdoptedByName, fmt.Sprintf("Adopted existing policy %s by name", id)))
+		return
+	}
+	c.log.Info("Policy ID changed", "old-id", cr.Spec.ForProvider.ID, "id", id)
+	c.record.Event(cr, event.Normal(reasonIDChanged, fmt.Sprintf("Policy ID changed from %s to %s", cr.Spec.ForProvider.ID, id)))
 }
 
 // GetAlertsPolicyIDByName uses the REST v2 API to return the ID for a given policy name
 func (c *external) GetAlertsPolicyIDByName(ctx context.Context, cr *v1alpha1.AlertsPolicy) (int, error) {
 	// Otherwise, look up the policy by name instead
 	listParams := &alerts.ListPoliciesParams{Name: cr.Spec.ForProvider.Name}
-	listPolicies, err := c.client.Alerts.ListPoliciesWithContext(ctx, listParams)
+	listPolicies, err := c.alerts.ListPoliciesWithContext(ctx, listParams)
 	if len(listPolicies) > 0 && err == nil {
 		return listPolicies[0].ID, nil
 	}
-	return 0, err
+	return 0, nrerrors.Classify(err)
 }
 
 // IsUpToDate determines whether the AlertsPolicy needs to be updated
@@ -17,13 +17,23 @@ limitations under the License.
 package alertspolicy
 
 import (
+	"context"
 	"testing"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	nrerrs "github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane-contrib/provider-newrelic/apis/alertspolicy/v1alpha1"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/fake"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
 )
 
 // Unlike many Kubernetes projects Crossplane does not use third party testing
@@ -106,3 +116,366 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+var errBoom = errors.New("boom")
+
+func nrPolicy(id, name string) *alerts.AlertsPolicy {
+	return &alerts.AlertsPolicy{
+		ID:                 id,
+		Name:               name,
+		IncidentPreference: alerts.AlertsIncidentPreference(alerts.IncidentPreferenceTypes.PerCondition),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		alerts *fake.MockAlertsClient
+		mg     resource.Managed
+	}
+
+	type want struct {
+		o      managed.ExternalObservation
+		id     string
+		events []event.Reason
+		err    error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotAlertsPolicy": {
+			args: args{alerts: &fake.MockAlertsClient{}},
+			want: want{err: errors.New(errNotPolicy)},
+		},
+		"UpToDate": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockQueryPolicyWithContext: func(_ context.Context, _ int, id string) (*alerts.AlertsPolicy, error) {
+						return nrPolicy(id, "test_name"), nil
+					},
+				},
+				mg: alertPolicy(),
+			},
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: GenerateConnectionDetails("1")},
+				id: "1",
+			},
+		},
+		"Outdated": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockQueryPolicyWithContext: func(_ context.Context, _ int, id string) (*alerts.AlertsPolicy, error) {
+						return nrPolicy(id, "renamed"), nil
+					},
+				},
+				mg: alertPolicy(),
+			},
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: GenerateConnectionDetails("1")},
+				id: "1",
+			},
+		},
+		"IDChanged": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockQueryPolicyWithContext: func(_ context.Context, _ int, id string) (*alerts.AlertsPolicy, error) {
+						if id == "1" {
+							return nil, nrerrs.NewNotFound("resource not found")
+						}
+						return nrPolicy(id, "test_name"), nil
+					},
+					MockListPoliciesWithContext: func(_ context.Context, _ *alerts.ListPoliciesParams) ([]alerts.Policy, error) {
+						return []alerts.Policy{{ID: 2, Name: "test_name"}}, nil
+					},
+				},
+				mg: alertPolicy(),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: GenerateConnectionDetails("2")},
+				id:     "2",
+				events: []event.Reason{reasonIDChanged},
+			},
+		},
+		"AdoptedByName": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockQueryPolicyWithContext: func(_ context.Context, _ int, id string) (*alerts.AlertsPolicy, error) {
+						if id == "" {
+							return nil, nrerrs.NewNotFound("resource not found")
+						}
+						return nrPolicy(id, "test_name"), nil
+					},
+					MockListPoliciesWithContext: func(_ context.Context, _ *alerts.ListPoliciesParams) ([]alerts.Policy, error) {
+						return []alerts.Policy{{ID: 2, Name: "test_name"}}, nil
+					},
+				},
+				mg: alertPolicy(func(cr *v1alpha1.AlertsPolicy) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: GenerateConnectionDetails("2")},
+				id:     "2",
+				events: []event.Reason{reasonAdoptedByName},
+			},
+		},
+		"NotFound": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockQueryPolicyWithContext: func(_ context.Context, _ int, _ string) (*alerts.AlertsPolicy, error) {
+						return nil, nrerrs.NewNotFound("resource not found")
+					},
+					MockListPoliciesWithContext: func(_ context.Context, _ *alerts.ListPoliciesParams) ([]alerts.Policy, error) {
+						return nil, nil
+					},
+				},
+				mg: alertPolicy(),
+			},
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: false},
+				id: "1",
+			},
+		}, "Unauthorized": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockQueryPolicyWithContext: func(_ context.Context, _ int, _ string) (*alerts.AlertsPolicy, error) {
+						return nil, nrerrs.NewUnauthorizedError()
+					},
+				},
+				mg: alertPolicy(),
+			},
+			want: want{
+				err: &nrerrors.Error{Reason: nrerrors.ReasonUnauthorized, Err: nrerrs.NewUnauthorizedError()},
+				id:  "1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			record := &fake.EventRecorder{}
+			e := external{alerts: tc.args.alerts, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, accountID: 1234567, log: logging.NewNopLogger(), record: record}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.AlertsPolicy); ok {
+				if diff := cmp.Diff(tc.want.id, cr.Spec.ForProvider.ID); diff != "" {
+					t.Errorf("e.Observe(...): -want id, +got id:\n%s\n", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.events, record.Reasons); diff != "" {
+				t.Errorf("e.Observe(...): -want events, +got events:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		alerts *fake.MockAlertsClient
+		mg     resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		id  string
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotAlertsPolicy": {
+			args: args{alerts: &fake.MockAlertsClient{}},
+			want: want{err: errors.New(errNotPolicy)},
+		},
+		"Created": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockCreatePolicyMutationWithContext: func(_ context.Context, _ int, p alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error) {
+						return nrPolicy("42", p.Name), nil
+					},
+					MockUpdatePolicyChannelsWithContext: func(_ context.Context, _ int, _ []int) (*alerts.PolicyChannels, error) {
+						return &alerts.PolicyChannels{}, nil
+					},
+				},
+				mg: alertPolicy(func(cr *v1alpha1.AlertsPolicy) {
+					cr.Spec.ForProvider.ID = ""
+					meta.SetExternalName(cr, "")
+				}),
+			},
+			want: want{
+				o:  managed.ExternalCreation{ConnectionDetails: GenerateConnectionDetails("42")},
+				id: "42",
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockCreatePolicyMutationWithContext: func(_ context.Context, _ int, _ alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error) {
+						return nil, errBoom
+					},
+				},
+				mg: alertPolicy(func(cr *v1alpha1.AlertsPolicy) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{err: nrerrors.Classify(errBoom)},
+		},
+		"AssignChannelsFailed": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockCreatePolicyMutationWithContext: func(_ context.Context, _ int, p alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error) {
+						return nrPolicy("42", p.Name), nil
+					},
+					MockUpdatePolicyChannelsWithContext: func(_ context.Context, _ int, _ []int) (*alerts.PolicyChannels, error) {
+						return nil, errBoom
+					},
+				},
+				mg: alertPolicy(func(cr *v1alpha1.AlertsPolicy) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{id: "42", err: nrerrors.Classify(errBoom)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{alerts: tc.args.alerts, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, accountID: 1234567}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.AlertsPolicy); ok {
+				if diff := cmp.Diff(tc.want.id, cr.Spec.ForProvider.ID); diff != "" {
+					t.Errorf("e.Create(...): -want id, +got id:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		alerts *fake.MockAlertsClient
+		mg     resource.Managed
+	}
+
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"NotAlertsPolicy": {
+			args: args{alerts: &fake.MockAlertsClient{}},
+			want: errors.New(errNotPolicy),
+		},
+		"Updated": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockUpdatePolicyMutationWithContext: func(_ context.Context, _ int, id string, p alerts.AlertsPolicyUpdateInput) (*alerts.AlertsPolicy, error) {
+						return nrPolicy(id, p.Name), nil
+					},
+					MockUpdatePolicyChannelsWithContext: func(_ context.Context, _ int, _ []int) (*alerts.PolicyChannels, error) {
+						return &alerts.PolicyChannels{}, nil
+					},
+				},
+				mg: alertPolicy(),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockUpdatePolicyMutationWithContext: func(_ context.Context, _ int, _ string, _ alerts.AlertsPolicyUpdateInput) (*alerts.AlertsPolicy, error) {
+						return nil, errBoom
+					},
+				},
+				mg: alertPolicy(),
+			},
+			want: nrerrors.Classify(errBoom),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{alerts: tc.args.alerts, kube: &test.MockClient{}, accountID: 1234567}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		alerts *fake.MockAlertsClient
+		mg     resource.Managed
+	}
+
+	cases := map[string]struct {
+		args   args
+		want   error
+		events []event.Reason
+	}{
+		"NotAlertsPolicy": {
+			args: args{alerts: &fake.MockAlertsClient{}},
+			want: errors.New(errNotPolicy),
+		},
+		"NoID": {
+			args: args{
+				alerts: &fake.MockAlertsClient{},
+				mg:     alertPolicy(func(cr *v1alpha1.AlertsPolicy) { cr.Spec.ForProvider.ID = "" }),
+			},
+			events: []event.Reason{reasonSkippedDelete},
+		},
+		"Deleted": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockDeletePolicyMutationWithContext: func(_ context.Context, _ int, id string) (*alerts.AlertsPolicy, error) {
+						return nrPolicy(id, "test_name"), nil
+					},
+				},
+				mg: alertPolicy(),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockDeletePolicyMutationWithContext: func(_ context.Context, _ int, _ string) (*alerts.AlertsPolicy, error) {
+						return nil, nrerrs.NewNotFound("resource not found")
+					},
+				},
+				mg: alertPolicy(),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockDeletePolicyMutationWithContext: func(_ context.Context, _ int, _ string) (*alerts.AlertsPolicy, error) {
+						return nil, errBoom
+					},
+				},
+				mg: alertPolicy(),
+			},
+			want: nrerrors.Classify(errBoom),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			record := &fake.EventRecorder{}
+			e := external{alerts: tc.args.alerts, kube: &test.MockClient{}, accountID: 1234567, log: logging.NewNopLogger(), record: record}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.events, record.Reasons); diff != "" {
+				t.Errorf("e.Delete(...): -want events, +got events:\n%s\n", diff)
+			}
+		})
+	}
+}
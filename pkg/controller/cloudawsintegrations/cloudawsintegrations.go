@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudawsintegrations
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotCloudAwsIntegrations = "managed resource is not a CloudAwsIntegrations custom resource"
+	errTrackPCUsage            = "cannot track ProviderConfig usage"
+	errGetPC                   = "cannot get ProviderConfig"
+	errNoLinkedAccount         = "linkedAccountId is not set"
+)
+
+// Setup adds a controller that reconciles CloudAwsIntegrations.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CloudAwsIntegrationsGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.CloudAwsIntegrationsKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CloudAwsIntegrationsGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.CloudAwsIntegrations{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CloudAwsIntegrations)
+	if !ok {
+		return nil, errors.New(errNotCloudAwsIntegrations)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client    *newrelic.NewRelic
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CloudAwsIntegrations)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudAwsIntegrations)
+	}
+
+	// The external name is set to the linked account once the integrations are configured
+	if meta.GetExternalName(cr) == "" || cr.Spec.ForProvider.LinkedAccountID == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	linkedAccount, err := c.client.Cloud.GetLinkedAccountWithContext(ctx, c.accountID, int(*cr.Spec.ForProvider.LinkedAccountID))
+	if err != nil {
+		if nrerrors.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
+	}
+	if linkedAccount == nil || linkedAccount.ID == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, ids, err := nr.GetCloudIntegrationSettings(linkedAccount)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Update the status
+	cr.Status.AtProvider = v1alpha1.CloudAwsIntegrationsObservation{
+		Integrations: GenerateIntegrationObservations(ids),
+	}
+
+	upToDate, err := IsUpToDate(cr, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CloudAwsIntegrations)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudAwsIntegrations)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if cr.Spec.ForProvider.LinkedAccountID == nil {
+		return managed.ExternalCreation{}, errors.New(errNoLinkedAccount)
+	}
+	if err := c.ConfigureIntegrations(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// The integrations are identified by the account they are enabled on
+	meta.SetExternalName(cr, strconv.FormatInt(*cr.Spec.ForProvider.LinkedAccountID, 10))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CloudAwsIntegrations)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudAwsIntegrations)
+	}
+
+	linkedAccountID := int(*cr.Spec.ForProvider.LinkedAccountID)
+	if err := c.ConfigureIntegrations(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Disable the integrations that were removed from the spec
+	linkedAccount, err := c.client.Cloud.GetLinkedAccountWithContext(ctx, c.accountID, linkedAccountID)
+	if err != nil {
+		return managed.ExternalUpdate{}, nrerrors.Classify(err)
+	}
+	observed, _, err := nr.GetCloudIntegrationSettings(linkedAccount)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	desired, err := GenerateIntegrationSettings(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	names := nr.CloudIntegrationNamesToDisable(desired, observed, cloud.CloudAwsIntegrationsInput{})
+	if len(names) > 0 {
+		if err := c.DisableIntegrations(ctx, linkedAccountID, names); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CloudAwsIntegrations)
+	if !ok {
+		return errors.New(errNotCloudAwsIntegrations)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Spec.ForProvider.LinkedAccountID == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(cr.Spec.ForProvider.Integrations))
+	for _, integration := range cr.Spec.ForProvider.Integrations {
+		names = append(names, integration.Service)
+	}
+	return c.DisableIntegrations(ctx, int(*cr.Spec.ForProvider.LinkedAccountID), names)
+}
+
+// ConfigureIntegrations enables the integrations in the spec with their settings
+func (c *external) ConfigureIntegrations(ctx context.Context, cr *v1alpha1.CloudAwsIntegrations) error {
+	settings, err := GenerateIntegrationSettings(cr)
+	if err != nil {
+		return err
+	}
+	input := cloud.CloudAwsIntegrationsInput{}
+	if err := nr.GenerateCloudIntegrationsInput(int(*cr.Spec.ForProvider.LinkedAccountID), settings, &input); err != nil {
+		return err
+	}
+
+	response, err := c.client.Cloud.CloudConfigureIntegrationWithContext(ctx, c.accountID, cloud.CloudIntegrationsInput{Aws: input})
+	if err != nil {
+		return nrerrors.Classify(err)
+	}
+	if len(response.Errors) > 0 {
+		return nrerrors.New(response.Errors[0].Type, response.Errors[0].Message)
+	}
+	return nil
+}
+
+// DisableIntegrations disables the named integrations on a linked account
+func (c *external) DisableIntegrations(ctx context.Context, linkedAccountID int, names []string) error {
+	input := cloud.CloudAwsDisableIntegrationsInput{}
+	if err := nr.GenerateCloudDisableIntegrationsInput(linkedAccountID, names, &input); err != nil {
+		return nrerrors.Classify(err)
+	}
+
+	response, err := c.client.Cloud.CloudDisableIntegrationWithContext(ctx, c.accountID, cloud.CloudDisableIntegrationsInput{Aws: input})
+	if err != nil {
+		return nrerrors.Classify(err)
+	}
+	if len(response.Errors) > 0 {
+		return nrerrors.New(response.Errors[0].Type, response.Errors[0].Message)
+	}
+	return nil
+}
+
+// GenerateIntegrationSettings converts the integrations in the spec into settings keyed by service
+func GenerateIntegrationSettings(cr *v1alpha1.CloudAwsIntegrations) (nr.CloudIntegrationSettings, error) {
+	settings := nr.CloudIntegrationSettings{}
+	for _, integration := range cr.Spec.ForProvider.Integrations {
+		setting, err := nr.GenerateCloudIntegrationSetting(integration)
+		if err != nil {
+			return nil, err
+		}
+		settings[integration.Service] = setting
+	}
+	return settings, nil
+}
+
+// GenerateIntegrationObservations lists the enabled integrations, sorted by service
+func GenerateIntegrationObservations(ids map[string]int) []v1alpha1.CloudIntegrationObservation {
+	observations := make([]v1alpha1.CloudIntegrationObservation, 0, len(ids))
+	for service, id := range ids {
+		observations = append(observations, v1alpha1.CloudIntegrationObservation{Service: service, ID: id})
+	}
+	sort.Slice(observations, func(i, j int) bool {
+		return observations[i].Service < observations[j].Service
+	})
+	return observations
+}
+
+// IsUpToDate determines whether the CloudAwsIntegrations needs to be updated
+func IsUpToDate(p *v1alpha1.CloudAwsIntegrations, observed nr.CloudIntegrationSettings) (bool, error) {
+	desired, err := GenerateIntegrationSettings(p)
+	if err != nil {
+		return false, err
+	}
+	return nr.CloudIntegrationsAreEqual(desired, observed, cloud.CloudAwsIntegrationsInput{}), nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudawsintegrations
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+)
+
+type integrationsModifier func(*v1alpha1.CloudAwsIntegrations)
+
+func integrations(m ...integrationsModifier) *v1alpha1.CloudAwsIntegrations {
+	cr := &v1alpha1.CloudAwsIntegrations{
+		Spec: v1alpha1.CloudAwsIntegrationsSpec{
+			ForProvider: v1alpha1.CloudAwsIntegrationsParameters{
+				LinkedAccountID: pointy.Int64(123456),
+				Integrations: []v1alpha1.AwsIntegration{
+					{
+						Service:                "lambda",
+						MetricsPollingInterval: pointy.Int(300),
+						AwsRegions:             []string{"us-east-1", "eu-west-1"},
+						FetchTags:              pointy.Bool(true),
+					},
+					{
+						Service:                "awsMsk",
+						MetricsPollingInterval: pointy.Int(300),
+						// Not supported by the MSK integration
+						FetchExtendedInventory: pointy.Bool(true),
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, "123456")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func linkedAccount(integrations ...cloud.CloudIntegrationInterface) *cloud.CloudLinkedAccount {
+	return &cloud.CloudLinkedAccount{ID: 123456, Integrations: integrations}
+}
+
+func lambda() *cloud.CloudLambdaIntegration {
+	return &cloud.CloudLambdaIntegration{
+		ID:                     1,
+		MetricsPollingInterval: 300,
+		AwsRegions:             []string{"eu-west-1", "us-east-1"},
+		FetchTags:              true,
+	}
+}
+
+func msk() *cloud.CloudAwsMskIntegration {
+	return &cloud.CloudAwsMskIntegration{
+		ID:                     2,
+		MetricsPollingInterval: 300,
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.CloudAwsIntegrations
+		nr *cloud.CloudLinkedAccount
+	}
+
+	type want struct {
+		expected bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DiffPollingInterval": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(lambda(), &cloud.CloudAwsMskIntegration{ID: 2, MetricsPollingInterval: 900}),
+			},
+			want: want{expected: false},
+		},
+		"DiffRegions": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(&cloud.CloudLambdaIntegration{
+					ID:                     1,
+					MetricsPollingInterval: 300,
+					AwsRegions:             []string{"us-east-1"},
+					FetchTags:              true,
+				}, msk()),
+			},
+			want: want{expected: false},
+		},
+		"MissingIntegration": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(lambda()),
+			},
+			want: want{expected: false},
+		},
+		"ExtraIntegration": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(lambda(), msk(), &cloud.CloudS3Integration{ID: 3}),
+			},
+			want: want{expected: false},
+		},
+		"IgnoresUnsetSettings": {
+			args: args{cr: *integrations(func(cr *v1alpha1.CloudAwsIntegrations) {
+				cr.Spec.ForProvider.Integrations[0].AwsRegions = nil
+			}),
+				nr: linkedAccount(lambda(), msk()),
+			},
+			want: want{expected: true},
+		},
+		"FalseEqualsOmitted": {
+			args: args{cr: *integrations(func(cr *v1alpha1.CloudAwsIntegrations) {
+				cr.Spec.ForProvider.Integrations[0].FetchTags = pointy.Bool(false)
+			}),
+				nr: linkedAccount(&cloud.CloudLambdaIntegration{
+					ID:                     1,
+					MetricsPollingInterval: 300,
+					AwsRegions:             []string{"us-east-1", "eu-west-1"},
+				}, msk()),
+			},
+			want: want{expected: true},
+		},
+		"Same": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(lambda(), msk()),
+			},
+			want: want{expected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed, _, err := nr.GetCloudIntegrationSettings(tc.args.nr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := IsUpToDate(&tc.args.cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
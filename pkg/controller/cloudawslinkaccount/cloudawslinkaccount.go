@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudawslinkaccount
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotCloudAwsLinkAccount = "managed resource is not a CloudAwsLinkAccount custom resource"
+	errTrackPCUsage           = "cannot track ProviderConfig usage"
+	errGetPC                  = "cannot get ProviderConfig"
+	errNoArn                  = "either arn or arnRef must be set"
+	errBadExternalName        = "external name is not a linked account ID"
+
+	providerAws = "aws"
+)
+
+// Setup adds a controller that reconciles CloudAwsLinkAccount.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CloudAwsLinkAccountGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.CloudAwsLinkAccountKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CloudAwsLinkAccountGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.CloudAwsLinkAccount{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CloudAwsLinkAccount)
+	if !ok {
+		return nil, errors.New(errNotCloudAwsLinkAccount)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client    *newrelic.NewRelic
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CloudAwsLinkAccount)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudAwsLinkAccount)
+	}
+
+	linkedAccount, err := c.GetLinkedAccountByIDOrArn(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if linkedAccount == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update the status
+	cr.Status.AtProvider = GenerateObservation(*linkedAccount)
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  IsUpToDate(cr, *linkedAccount),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CloudAwsLinkAccount)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudAwsLinkAccount)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if cr.Spec.ForProvider.Arn == "" {
+		return managed.ExternalCreation{}, errors.New(errNoArn)
+	}
+
+	input := cloud.CloudLinkCloudAccountsInput{
+		Aws: []cloud.CloudAwsLinkAccountInput{{
+			Arn:                  cr.Spec.ForProvider.Arn,
+			MetricCollectionMode: cloud.CloudMetricCollectionMode(cr.Spec.ForProvider.MetricCollectionMode),
+			Name:                 cr.Spec.ForProvider.Name,
+		}},
+	}
+	response, err := c.client.Cloud.CloudLinkAccountWithContext(ctx, c.accountID, input)
+	if err != nil {
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
+	}
+	if len(response.Errors) > 0 {
+		return managed.ExternalCreation{}, nrerrors.New(response.Errors[0].Type, response.Errors[0].Message)
+	}
+	if len(response.LinkedAccounts) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+
+	// The linked account ID is the identity of the linked account
+	meta.SetExternalName(cr, strconv.Itoa(response.LinkedAccounts[0].ID))
+	cr.Status.AtProvider = GenerateObservation(response.LinkedAccounts[0])
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CloudAwsLinkAccount)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudAwsLinkAccount)
+	}
+
+	// Only the name can be changed once an account is linked
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBadExternalName)
+	}
+	input := []cloud.CloudRenameAccountsInput{{
+		LinkedAccountId: id,
+		Name:            cr.Spec.ForProvider.Name,
+	}}
+	response, err := c.client.Cloud.CloudRenameAccountWithContext(ctx, c.accountID, input)
+	if err != nil {
+		return managed.ExternalUpdate{}, nrerrors.Classify(err)
+	}
+	if len(response.Errors) > 0 {
+		return managed.ExternalUpdate{}, nrerrors.New(response.Errors[0].Type, response.Errors[0].Message)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CloudAwsLinkAccount)
+	if !ok {
+		return errors.New(errNotCloudAwsLinkAccount)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.Wrap(err, errBadExternalName)
+	}
+
+	response, err := c.client.Cloud.CloudUnlinkAccountWithContext(ctx, c.accountID, []cloud.CloudUnlinkAccountsInput{{LinkedAccountId: id}})
+	if err != nil {
+		return nrerrors.IgnoreNotFound(err)
+	}
+	if len(response.Errors) > 0 {
+		return nrerrors.IgnoreNotFound(nrerrors.New(response.Errors[0].Type, response.Errors[0].Message))
+	}
+	return nil
+}
+
+// GetLinkedAccountByIDOrArn returns the linked account identified by the external name.
+// Without an external name it falls back to an AWS account already linked with the same role
+// ARN, so accounts linked by hand can be adopted. Returns nil if no account was found.
+func (c *external) GetLinkedAccountByIDOrArn(ctx context.Context, cr *v1alpha1.CloudAwsLinkAccount) (*cloud.CloudLinkedAccount, error) {
+	if ext := meta.GetExternalName(cr); ext != "" {
+		id, err := strconv.Atoi(ext)
+		if err != nil {
+			return nil, errors.Wrap(err, errBadExternalName)
+		}
+		linkedAccount, err := c.client.Cloud.GetLinkedAccountWithContext(ctx, c.accountID, id)
+		if err != nil {
+			if nrerrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, nrerrors.Classify(err)
+		}
+		// An unknown ID returns an empty linked account
+		if linkedAccount == nil || linkedAccount.ID == 0 {
+			return nil, nil
+		}
+		return linkedAccount, nil
+	}
+
+	if cr.Spec.ForProvider.Arn == "" {
+		return nil, nil
+	}
+	linkedAccounts, err := c.client.Cloud.GetLinkedAccountsWithContext(ctx, providerAws)
+	if err != nil {
+		// No accounts are linked yet
+		if nrerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, nrerrors.Classify(err)
+	}
+	for _, linkedAccount := range *linkedAccounts {
+		if linkedAccount.NrAccountId == c.accountID && linkedAccount.AuthLabel == cr.Spec.ForProvider.Arn {
+			meta.SetExternalName(cr, strconv.Itoa(linkedAccount.ID))
+			_ = c.kube.Update(ctx, cr)
+			return &linkedAccount, nil
+		}
+	}
+	return nil, nil
+}
+
+// GenerateObservation produces a CloudAwsLinkAccountObservation from a linked account
+func GenerateObservation(linkedAccount cloud.CloudLinkedAccount) v1alpha1.CloudAwsLinkAccountObservation {
+	return v1alpha1.CloudAwsLinkAccountObservation{
+		ID:         linkedAccount.ID,
+		ExternalID: linkedAccount.ExternalId,
+		AuthLabel:  linkedAccount.AuthLabel,
+		Disabled:   linkedAccount.Disabled,
+	}
+}
+
+// IsUpToDate determines whether the CloudAwsLinkAccount needs to be updated
+func IsUpToDate(p *v1alpha1.CloudAwsLinkAccount, linkedAccount cloud.CloudLinkedAccount) bool {
+	return cmp.Equal(p.Spec.ForProvider.Name, linkedAccount.Name, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudawslinkaccount
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
+)
+
+type linkAccountModifier func(*v1alpha1.CloudAwsLinkAccount)
+
+func linkAccount(m ...linkAccountModifier) *v1alpha1.CloudAwsLinkAccount {
+	cr := &v1alpha1.CloudAwsLinkAccount{
+		Spec: v1alpha1.CloudAwsLinkAccountSpec{
+			ForProvider: v1alpha1.CloudAwsLinkAccountParameters{
+				Name:                 "production",
+				Arn:                  "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+				MetricCollectionMode: "PULL",
+			},
+		},
+	}
+	meta.SetExternalName(cr, "123456")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.CloudAwsLinkAccount
+		nr cloud.CloudLinkedAccount
+	}
+
+	type want struct {
+		expected bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DiffName": {
+			args: args{cr: *linkAccount(),
+				nr: cloud.CloudLinkedAccount{
+					ID:        123456,
+					Name:      "staging",
+					AuthLabel: "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+				},
+			},
+			want: want{expected: false},
+		},
+		"IgnoresMetricCollectionMode": {
+			args: args{cr: *linkAccount(func(cr *v1alpha1.CloudAwsLinkAccount) {
+				cr.Spec.ForProvider.MetricCollectionMode = "PUSH"
+			}),
+				nr: cloud.CloudLinkedAccount{
+					ID:                   123456,
+					Name:                 "production",
+					AuthLabel:            "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+					MetricCollectionMode: cloud.CloudMetricCollectionModeTypes.PULL,
+				},
+			},
+			want: want{expected: true},
+		},
+		"Same": {
+			args: args{cr: *linkAccount(),
+				nr: cloud.CloudLinkedAccount{
+					ID:                   123456,
+					Name:                 "production",
+					AuthLabel:            "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+					MetricCollectionMode: cloud.CloudMetricCollectionModeTypes.PULL,
+				},
+			},
+			want: want{expected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(&tc.args.cr, tc.args.nr)
+			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
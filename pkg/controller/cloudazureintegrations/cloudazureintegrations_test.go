@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudazureintegrations
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+)
+
+type integrationsModifier func(*v1alpha1.CloudAzureIntegrations)
+
+func integrations(m ...integrationsModifier) *v1alpha1.CloudAzureIntegrations {
+	cr := &v1alpha1.CloudAzureIntegrations{
+		Spec: v1alpha1.CloudAzureIntegrationsSpec{
+			ForProvider: v1alpha1.CloudAzureIntegrationsParameters{
+				LinkedAccountID: pointy.Int64(123456),
+				Integrations: []v1alpha1.AzureIntegration{
+					{
+						Service:                "azureSql",
+						MetricsPollingInterval: pointy.Int(300),
+						ResourceGroups:         []string{"payments", "checkout"},
+					},
+					{
+						Service:                "azureMonitor",
+						MetricsPollingInterval: pointy.Int(300),
+						IncludeTags:            []string{"env:production"},
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, "123456")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func linkedAccount(integrations ...cloud.CloudIntegrationInterface) *cloud.CloudLinkedAccount {
+	return &cloud.CloudLinkedAccount{ID: 123456, Integrations: integrations}
+}
+
+func sql() *cloud.CloudAzureSqlIntegration {
+	return &cloud.CloudAzureSqlIntegration{
+		ID:                     1,
+		MetricsPollingInterval: 300,
+		ResourceGroups:         []string{"checkout", "payments"},
+	}
+}
+
+func monitor() *cloud.CloudAzureMonitorIntegration {
+	return &cloud.CloudAzureMonitorIntegration{
+		ID:                     2,
+		MetricsPollingInterval: 300,
+		IncludeTags:            []string{"env:production"},
+		Enabled:                true,
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.CloudAzureIntegrations
+		nr *cloud.CloudLinkedAccount
+	}
+
+	type want struct {
+		expected bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DiffResourceGroups": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(&cloud.CloudAzureSqlIntegration{
+					ID:                     1,
+					MetricsPollingInterval: 300,
+					ResourceGroups:         []string{"checkout"},
+				}, monitor()),
+			},
+			want: want{expected: false},
+		},
+		"DiffIncludeTags": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(sql(), &cloud.CloudAzureMonitorIntegration{
+					ID:                     2,
+					MetricsPollingInterval: 300,
+					IncludeTags:            []string{"env:staging"},
+				}),
+			},
+			want: want{expected: false},
+		},
+		"MissingIntegration": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(sql()),
+			},
+			want: want{expected: false},
+		},
+		"Same": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(sql(), monitor()),
+			},
+			want: want{expected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed, _, err := nr.GetCloudIntegrationSettings(tc.args.nr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := IsUpToDate(&tc.args.cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
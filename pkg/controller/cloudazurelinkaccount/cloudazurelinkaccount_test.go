@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudazurelinkaccount
+
+import (
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
+)
+
+type linkAccountModifier func(*v1alpha1.CloudAzureLinkAccount)
+
+func linkAccount(m ...linkAccountModifier) *v1alpha1.CloudAzureLinkAccount {
+	cr := &v1alpha1.CloudAzureLinkAccount{
+		Spec: v1alpha1.CloudAzureLinkAccountSpec{
+			ForProvider: v1alpha1.CloudAzureLinkAccountParameters{
+				Name:          "production",
+				ApplicationID: "0b1e3a52-5f1c-4d0a-9f38-8c2e1f6b9a10",
+				ClientSecretRef: xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "azure-newrelic", Namespace: "crossplane-system"},
+					Key:             "clientSecret",
+				},
+				SubscriptionID: "5d2f7a9e-3c41-4b8e-a6f0-1e9b2c7d4a83",
+				TenantID:       "9a4c6e21-7b3d-4f5a-8e12-6d0c3b9f2e74",
+			},
+		},
+	}
+	meta.SetExternalName(cr, "123456")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.CloudAzureLinkAccount
+		nr cloud.CloudLinkedAccount
+	}
+
+	type want struct {
+		expected bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DiffName": {
+			args: args{cr: *linkAccount(),
+				nr: cloud.CloudLinkedAccount{
+					ID:         123456,
+					Name:       "staging",
+					ExternalId: "5d2f7a9e-3c41-4b8e-a6f0-1e9b2c7d4a83",
+					AuthLabel:  "0b1e3a52-5f1c-4d0a-9f38-8c2e1f6b9a10",
+				},
+			},
+			want: want{expected: false},
+		},
+		"Same": {
+			args: args{cr: *linkAccount(),
+				nr: cloud.CloudLinkedAccount{
+					ID:         123456,
+					Name:       "production",
+					ExternalId: "5d2f7a9e-3c41-4b8e-a6f0-1e9b2c7d4a83",
+					AuthLabel:  "0b1e3a52-5f1c-4d0a-9f38-8c2e1f6b9a10",
+				},
+			},
+			want: want{expected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(&tc.args.cr, tc.args.nr)
+			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudgcpintegrations
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+)
+
+type integrationsModifier func(*v1alpha1.CloudGcpIntegrations)
+
+func integrations(m ...integrationsModifier) *v1alpha1.CloudGcpIntegrations {
+	cr := &v1alpha1.CloudGcpIntegrations{
+		Spec: v1alpha1.CloudGcpIntegrationsSpec{
+			ForProvider: v1alpha1.CloudGcpIntegrationsParameters{
+				LinkedAccountID: pointy.Int64(123456),
+				Integrations: []v1alpha1.GcpIntegration{
+					{
+						Service:                "gcpBigquery",
+						MetricsPollingInterval: pointy.Int(300),
+						FetchTags:              pointy.Bool(true),
+						FetchTableMetrics:      pointy.Bool(true),
+					},
+					{
+						Service:                "gcpVms",
+						MetricsPollingInterval: pointy.Int(300),
+						// Not supported by the VMs integration
+						FetchTableMetrics: pointy.Bool(true),
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, "123456")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func linkedAccount(integrations ...cloud.CloudIntegrationInterface) *cloud.CloudLinkedAccount {
+	return &cloud.CloudLinkedAccount{ID: 123456, Integrations: integrations}
+}
+
+func bigquery() *cloud.CloudGcpBigqueryIntegration {
+	return &cloud.CloudGcpBigqueryIntegration{
+		ID:                     1,
+		MetricsPollingInterval: 300,
+		FetchTags:              true,
+		FetchTableMetrics:      true,
+	}
+}
+
+func vms() *cloud.CloudGcpVmsIntegration {
+	return &cloud.CloudGcpVmsIntegration{
+		ID:                     2,
+		MetricsPollingInterval: 300,
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.CloudGcpIntegrations
+		nr *cloud.CloudLinkedAccount
+	}
+
+	type want struct {
+		expected bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DiffPollingInterval": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(bigquery(), &cloud.CloudGcpVmsIntegration{ID: 2, MetricsPollingInterval: 900}),
+			},
+			want: want{expected: false},
+		},
+		"DiffFetchTags": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(&cloud.CloudGcpBigqueryIntegration{
+					ID:                     1,
+					MetricsPollingInterval: 300,
+					FetchTableMetrics:      true,
+				}, vms()),
+			},
+			want: want{expected: false},
+		},
+		"ExtraIntegration": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(bigquery(), vms(), &cloud.CloudGcpPubsubIntegration{ID: 3}),
+			},
+			want: want{expected: false},
+		},
+		"Same": {
+			args: args{cr: *integrations(),
+				nr: linkedAccount(bigquery(), vms()),
+			},
+			want: want{expected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed, _, err := nr.GetCloudIntegrationSettings(tc.args.nr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := IsUpToDate(&tc.args.cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+)
+
+const (
+	errGetProviderConfig    = "cannot get ProviderConfig"
+	errUpdateProviderStatus = "cannot update ProviderConfig status"
+	errProbeAccount         = "cannot query NerdGraph actor"
+	errNoAccountAccess      = "API key has no access to the account"
+	errValidateAccount      = "cannot validate account %d"
+	errNotUserKey           = "NerdGraph requires a User API key (NRAK-...)"
+)
+
+// providerConfigRecheckInterval is how often a ProviderConfig is validated again,
+// e.g. to notice a revoked API key
+const providerConfigRecheckInterval = 10 * time.Minute
+
+// Key types of New Relic API keys, as reported in the ProviderConfig status
+const (
+	KeyTypeUser    = "USER"
+	KeyTypeLicense = "LICENSE"
+	KeyTypeIngest  = "INGEST"
+	KeyTypeBrowser = "BROWSER"
+	KeyTypeUnknown = "UNKNOWN"
+)
+
+// defaultRegion is the region the client uses when the ProviderConfig sets none
+const defaultRegion = "US"
+
+// An AccountInfo is what a probe learns about the account a ProviderConfig points to.
+type AccountInfo struct {
+	Name string
+}
+
+// A ProbeFn validates an API key against NerdGraph and returns the account it gives
+// access to.
+type ProbeFn func(ctx context.Context, apiKey string, region *string, accountID int, opts ...newrelic.ConfigOption) (*AccountInfo, error)
+
+// A ProviderConfigReconciler accounts for the usages of a ProviderConfig, then
+// validates its credentials and reports the result in its status.
+type ProviderConfigReconciler struct {
+	kube   client.Client
+	usage  reconcile.Reconciler
+	cache  *nr.ClientCache
+	probe  ProbeFn
+	log    logging.Logger
+	record event.Recorder
+	now    func() time.Time
+}
+
+// Reconcile a ProviderConfig. Credentials are probed when the spec changed or the
+// last validation is older than providerConfigRecheckInterval.
+func (r *ProviderConfigReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.usage.Reconcile(ctx, req)
+	if err != nil || res.Requeue || res.RequeueAfter > 0 {
+		return res, err
+	}
+	log := r.log.WithValues("request", req)
+
+	pc := &v1alpha1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		// The ProviderConfig is gone before its deletion was seen
+		if kerrors.IsNotFound(err) {
+			r.cache.DeleteByName(req.Name)
+		}
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if meta.WasDeleted(pc) {
+		r.cache.Delete(pc.GetUID())
+		return reconcile.Result{}, nil
+	}
+
+	if wait := r.nextValidation(pc); wait > 0 {
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	r.Validate(ctx, pc)
+	if c := pc.GetCondition(xpv1.TypeReady); c.Status != corev1.ConditionTrue {
+		log.Debug("ProviderConfig is not valid", "reason", c.Message)
+		r.record.Event(pc, event.Warning("ValidateProviderConfig", errors.New(c.Message)))
+	}
+	return reconcile.Result{RequeueAfter: providerConfigRecheckInterval}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateProviderStatus)
+}
+
+// nextValidation returns how long until the ProviderConfig is due for validation,
+// or zero when it is due now.
+func (r *ProviderConfigReconciler) nextValidation(pc *v1alpha1.ProviderConfig) time.Duration {
+	last := pc.Status.LastValidationTime
+	c := pc.GetCondition(xpv1.TypeReady)
+	if last == nil || c.ObservedGeneration != pc.GetGeneration() {
+		return 0
+	}
+	return last.Add(providerConfigRecheckInterval).Sub(r.now())
+}
+
+// Validate probes the credentials of a ProviderConfig and records the outcome in
+// its status.
+func (r *ProviderConfigReconciler) Validate(ctx context.Context, pc *v1alpha1.ProviderConfig) {
+	now := metav1.NewTime(r.now())
+	pc.Status.LastValidationTime = &now
+	pc.Status.Region = defaultRegion
+	if pc.Spec.Region != nil && *pc.Spec.Region != "" {
+		pc.Status.Region = strings.ToUpper(*pc.Spec.Region)
+	}
+
+	accounts, keyType, err := r.probeProviderConfig(ctx, pc)
+	pc.Status.KeyType = keyType
+	pc.Status.Accounts = accounts
+	if err != nil {
+		pc.Status.AccountName = ""
+		pc.SetConditions(xpv1.Unavailable().WithMessage(err.Error()).WithObservedGeneration(pc.GetGeneration()))
+		return
+	}
+	pc.Status.AccountName = accounts[0].Name
+	pc.SetConditions(xpv1.Available().WithObservedGeneration(pc.GetGeneration()))
+}
+
+// probeProviderConfig probes every account the ProviderConfig allows, so that a
+// key without access to one of them is reported before a managed resource
+// targets it. It returns the accounts probed successfully.
+func (r *ProviderConfigReconciler) probeProviderConfig(ctx context.Context, pc *v1alpha1.ProviderConfig) ([]v1alpha1.ProviderConfigAccount, string, error) {
+	accountIDs, err := nr.AllowedAccountIDs(pc)
+	if err != nil {
+		return nil, "", err
+	}
+	key, err := nr.ExtractNewRelicAPIKey(ctx, r.kube, pc)
+	if err != nil {
+		return nil, "", err
+	}
+	keyType := DetectKeyType(key)
+	if keyType != KeyTypeUser && keyType != KeyTypeUnknown {
+		return nil, keyType, errors.Errorf("%s, got a %s key", errNotUserKey, keyType)
+	}
+
+	ctx = nr.WithResourceKind(ctx, v1alpha1.ProviderConfigKind)
+	var accounts []v1alpha1.ProviderConfigAccount
+	for _, accountID := range accountIDs {
+		opts, err := nr.ClientOptions(ctx, r.kube, pc, accountID)
+		if err != nil {
+			return accounts, keyType, err
+		}
+		info, err := r.probe(ctx, key, pc.Spec.Region, accountID, opts...)
+		if err != nil {
+			return accounts, keyType, errors.Wrapf(err, errValidateAccount, accountID)
+		}
+		accounts = append(accounts, v1alpha1.ProviderConfigAccount{ID: accountID, Name: info.Name})
+	}
+	return accounts, keyType, nil
+}
+
+// DetectKeyType returns the type of a New Relic API key from its prefix or suffix
+// https://docs.newrelic.com/docs/apis/intro-apis/new-relic-api-keys/
+func DetectKeyType(key string) string {
+	switch {
+	case strings.HasPrefix(key, "NRAK-"):
+		return KeyTypeUser
+	case strings.HasSuffix(key, "NRAL"):
+		return KeyTypeLicense
+	case strings.HasPrefix(key, "NRII-"):
+		return KeyTypeIngest
+	case strings.HasPrefix(key, "NRJS-"):
+		return KeyTypeBrowser
+	}
+	return KeyTypeUnknown
+}
+
+// The account probe is not in the client
+const probeAccountQuery = `query($accountId: Int!) {
+	actor {
+		user { id email }
+		account(id: $accountId) { id name }
+	}
+}`
+
+// ProbeAccount checks that an API key is valid and has access to an account
+func ProbeAccount(ctx context.Context, apiKey string, region *string, accountID int, opts ...newrelic.ConfigOption) (*AccountInfo, error) {
+	client, err := nr.GetNewRelicClient(apiKey, region, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp := struct {
+		Actor struct {
+			User *struct {
+				ID    int    `json:"id"`
+				Email string `json:"email"`
+			} `json:"user"`
+			Account *struct {
+				ID   int    `json:"id"`
+				Name string `json:"name"`
+			} `json:"account"`
+		} `json:"actor"`
+	}{}
+	vars := map[string]interface{}{
+		"accountId": accountID,
+	}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, probeAccountQuery, vars, &resp); err != nil {
+		return nil, errors.Wrap(err, errProbeAccount)
+	}
+	if resp.Actor.Account == nil {
+		return nil, errors.New(errNoAccountAccess)
+	}
+	return &AccountInfo{Name: resp.Actor.Account.Name}, nil
+}
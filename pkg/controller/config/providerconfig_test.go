@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+)
+
+func TestDetectKeyType(t *testing.T) {
+	cases := map[string]struct {
+		key  string
+		want string
+	}{
+		"User":    {key: "NRAK-ABCDEFGHIJKLMNOPQRSTUVWXYZ0", want: KeyTypeUser},
+		"License": {key: "0123456789abcdef0123456789abcdef0123NRAL", want: KeyTypeLicense},
+		"Ingest":  {key: "NRII-abcdefghijklmnopqrstuvwxyz01", want: KeyTypeIngest},
+		"Browser": {key: "NRJS-0123456789abcdef012", want: KeyTypeBrowser},
+		"Unknown": {key: "0123456789abcdef", want: KeyTypeUnknown},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DetectKeyType(tc.key)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DetectKeyType(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eu := "eu"
+
+	type args struct {
+		key        string
+		region     *string
+		accountIDs []string
+		probe      ProbeFn
+	}
+
+	type want struct {
+		status v1alpha1.ProviderConfigStatus
+	}
+
+	withStatus := func(c xpv1.Condition, name, region, keyType string, accounts ...v1alpha1.ProviderConfigAccount) v1alpha1.ProviderConfigStatus {
+		s := v1alpha1.ProviderConfigStatus{AccountName: name, Accounts: accounts, Region: region, KeyType: keyType}
+		s.LastValidationTime = &metav1.Time{Time: now}
+		s.SetConditions(c)
+		return s
+	}
+	probed := func(name string, err error) ProbeFn {
+		return func(_ context.Context, _ string, _ *string, _ int, _ ...newrelic.ConfigOption) (*AccountInfo, error) {
+			if err != nil {
+				return nil, err
+			}
+			return &AccountInfo{Name: name}, nil
+		}
+	}
+	// accounts probes the accounts named by their IDs, and fails for the others
+	accounts := func(names map[int]string, err error) ProbeFn {
+		return func(_ context.Context, _ string, _ *string, accountID int, _ ...newrelic.ConfigOption) (*AccountInfo, error) {
+			if name, ok := names[accountID]; ok {
+				return &AccountInfo{Name: name}, nil
+			}
+			return nil, err
+		}
+	}
+	errBoom := errors.New("boom")
+	production := v1alpha1.ProviderConfigAccount{ID: 1234567, Name: "Production"}
+	staging := v1alpha1.ProviderConfigAccount{ID: 7654321, Name: "Staging"}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Valid": {
+			args: args{key: "NRAK-VALID", probe: probed("Production", nil)},
+			want: want{status: withStatus(xpv1.Available(), "Production", "US", KeyTypeUser, production)},
+		},
+		"ValidInEU": {
+			args: args{key: "NRAK-VALID", region: &eu, probe: probed("Production", nil)},
+			want: want{status: withStatus(xpv1.Available(), "Production", "EU", KeyTypeUser, production)},
+		},
+		"LicenseKey": {
+			args: args{key: "0123456789abcdefNRAL", probe: probed("Production", nil)},
+			want: want{status: withStatus(xpv1.Unavailable().WithMessage(errNotUserKey+", got a LICENSE key"), "", "US", KeyTypeLicense)},
+		},
+		"ProbeFailed": {
+			args: args{key: "NRAK-REVOKED", probe: probed("", errBoom)},
+			want: want{status: withStatus(xpv1.Unavailable().WithMessage("cannot validate account 1234567: boom"), "", "US", KeyTypeUser)},
+		},
+		"FurtherAccounts": {
+			args: args{
+				key:        "NRAK-VALID",
+				accountIDs: []string{"7654321", "1234567"},
+				probe:      accounts(map[int]string{1234567: "Production", 7654321: "Staging"}, nil),
+			},
+			want: want{status: withStatus(xpv1.Available(), "Production", "US", KeyTypeUser, production, staging)},
+		},
+		"NoAccessToFurtherAccount": {
+			args: args{
+				key:        "NRAK-VALID",
+				accountIDs: []string{"7654321", "1111111"},
+				probe:      accounts(map[int]string{1234567: "Production", 7654321: "Staging"}, errors.New(errNoAccountAccess)),
+			},
+			want: want{status: withStatus(xpv1.Unavailable().WithMessage("cannot validate account 1111111: "+errNoAccountAccess), "", "US", KeyTypeUser, production, staging)},
+		},
+		"InvalidFurtherAccount": {
+			args: args{key: "NRAK-VALID", accountIDs: []string{"staging"}, probe: probed("Production", nil)},
+			want: want{status: withStatus(xpv1.Unavailable().WithMessage(`cannot parse account_ids entry "staging": strconv.Atoi: parsing "staging": invalid syntax`), "", "US", "")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					if s, ok := obj.(*corev1.Secret); ok {
+						s.Data = map[string][]byte{"credentials": []byte(tc.args.key)}
+					}
+					return nil
+				},
+			}
+			r := &ProviderConfigReconciler{kube: kube, probe: tc.args.probe, now: func() time.Time { return now }}
+			pc := &v1alpha1.ProviderConfig{Spec: v1alpha1.ProviderConfigSpec{
+				AccountID:  "1234567",
+				AccountIDs: tc.args.accountIDs,
+				Region:     tc.args.region,
+				Credentials: v1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						SecretRef: &xpv1.SecretKeySelector{
+							SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "nr"},
+							Key:             "credentials",
+						},
+					},
+				},
+			}}
+			r.Validate(context.Background(), pc)
+			if diff := cmp.Diff(tc.want.status, pc.Status, test.EquateConditions(), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("r.Validate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestReconcileEvictsClients(t *testing.T) {
+	deleted := metav1.Now()
+
+	cases := map[string]struct {
+		get func(obj client.Object) error
+	}{
+		"Deleting": {
+			get: func(obj client.Object) error {
+				pc := obj.(*v1alpha1.ProviderConfig)
+				pc.SetName("default")
+				pc.SetUID("default-uid")
+				pc.SetDeletionTimestamp(&deleted)
+				return nil
+			},
+		},
+		"NotFound": {
+			get: func(_ client.Object) error {
+				return kerrors.NewNotFound(v1alpha1.SchemeGroupVersion.WithResource("providerconfigs").GroupResource(), "default")
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cache := nr.NewClientCache()
+			secrets := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"credentials": []byte("NRAK-VALID")}
+					return nil
+				},
+			}
+			for _, pcName := range []string{"default", "other"} {
+				pc := &v1alpha1.ProviderConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: pcName, UID: types.UID(pcName + "-uid")},
+					Spec: v1alpha1.ProviderConfigSpec{Credentials: v1alpha1.ProviderCredentials{
+						Source: xpv1.CredentialsSourceSecret,
+						CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+							SecretRef: &xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "nr"},
+								Key:             "credentials",
+							},
+						},
+					}},
+				}
+				if _, err := cache.Get(context.Background(), secrets, pc, 1234567); err != nil {
+					t.Fatalf("cache.Get(...): %v", err)
+				}
+			}
+
+			r := &ProviderConfigReconciler{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						return tc.get(obj)
+					},
+				},
+				usage: reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, nil
+				}),
+				cache: cache,
+				log:   logging.NewNopLogger(),
+			}
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}}); err != nil {
+				t.Fatalf("r.Reconcile(...): %v", err)
+			}
+			if diff := cmp.Diff(1, cache.Len()); diff != "" {
+				t.Errorf("r.Reconcile(...): -want cached clients, +got cached clients:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errGetStoreConfig    = "cannot get StoreConfig"
+	errUpdateStoreStatus = "cannot update StoreConfig status"
+	errNoPluginConfig    = "plugin store requires spec.plugin"
+	errNoPluginEndpoint  = "plugin store requires spec.plugin.endpoint"
+	errNoPluginConfigRef = "plugin store requires spec.plugin.configRef apiVersion, kind and name"
+	errNoPluginTLS       = "plugin store requires ESS TLS certificates, see --ess-tls-cert-dir"
+	errNoKubeAuthSource  = "kubernetes store requires spec.kubernetes.auth.source"
+	errBuildStore        = "cannot build secret store"
+)
+
+// storeConfigRecheckInterval is how often a valid StoreConfig is validated again,
+// e.g. to notice a rotated kubeconfig Secret
+const storeConfigRecheckInterval = 10 * time.Minute
+
+// storeConfigRetryInterval is how often an invalid StoreConfig is validated again,
+// since it may only need a kubeconfig Secret to be created or fixed
+const storeConfigRetryInterval = time.Minute
+
+// SetupStoreConfig adds a controller that validates StoreConfigs and reports
+// whether they can be used to publish connection details. It is only set up
+// when External Secret Stores are enabled.
+func SetupStoreConfig(mgr ctrl.Manager, o controller.Options) error {
+	if !o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		return nil
+	}
+	name := "store/" + strings.ToLower(v1alpha1.StoreConfigGroupKind)
+
+	r := &StoreConfigReconciler{
+		kube:   mgr.GetClient(),
+		tls:    nr.ESSTLSConfig(o),
+		log:    o.Logger.WithValues("controller", name),
+		record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.StoreConfig{}).
+		Complete(r)
+}
+
+// A StoreConfigReconciler validates StoreConfigs.
+type StoreConfigReconciler struct {
+	kube   client.Client
+	tls    *tls.Config
+	log    logging.Logger
+	record event.Recorder
+}
+
+// Reconcile a StoreConfig by validating it and reporting the result as its Ready condition.
+func (r *StoreConfigReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	sc := &v1alpha1.StoreConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, sc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetStoreConfig)
+	}
+
+	cond, requeue := xpv1.Available(), storeConfigRecheckInterval
+	err := r.Validate(ctx, sc.GetStoreConfig())
+	if err != nil {
+		log.Debug("StoreConfig is not valid", "error", err)
+		cond, requeue = xpv1.Unavailable().WithMessage(err.Error()), storeConfigRetryInterval
+	}
+
+	// Only a changed condition is written and reported, not every recheck
+	if sc.GetCondition(xpv1.TypeReady).Equal(cond) {
+		return reconcile.Result{RequeueAfter: requeue}, nil
+	}
+	if err != nil {
+		r.record.Event(sc, event.Warning("ValidateStoreConfig", err))
+	}
+	sc.SetConditions(cond)
+	return reconcile.Result{RequeueAfter: requeue}, errors.Wrap(r.kube.Status().Update(ctx, sc), errUpdateStoreStatus)
+}
+
+// Validate checks that a secret store config is complete. Kubernetes stores are built,
+// which reads the kubeconfig of an external API server. Plugin stores, e.g. the Vault
+// plugin, are only checked statically since they are dialed lazily.
+func (r *StoreConfigReconciler) Validate(ctx context.Context, cfg xpv1.SecretStoreConfig) error {
+	if err := ValidateStoreConfig(cfg, r.tls != nil); err != nil {
+		return err
+	}
+	if cfg.Type != nil && *cfg.Type != xpv1.SecretStoreKubernetes {
+		return nil
+	}
+	cfg.Type = storeType(xpv1.SecretStoreKubernetes)
+	if _, err := connection.RuntimeStoreBuilder(ctx, r.kube, r.tls, cfg); err != nil {
+		return errors.Wrap(err, errBuildStore)
+	}
+	return nil
+}
+
+// ValidateStoreConfig checks the fields a secret store config requires for its type
+func ValidateStoreConfig(cfg xpv1.SecretStoreConfig, hasTLS bool) error {
+	t := xpv1.SecretStoreKubernetes
+	if cfg.Type != nil {
+		t = *cfg.Type
+	}
+
+	switch t {
+	case xpv1.SecretStoreKubernetes:
+		if cfg.Kubernetes != nil && cfg.Kubernetes.Auth.Source == "" {
+			return errors.New(errNoKubeAuthSource)
+		}
+	case xpv1.SecretStorePlugin:
+		if cfg.Plugin == nil {
+			return errors.New(errNoPluginConfig)
+		}
+		if cfg.Plugin.Endpoint == "" {
+			return errors.New(errNoPluginEndpoint)
+		}
+		ref := cfg.Plugin.ConfigRef
+		if ref.APIVersion == "" || ref.Kind == "" || ref.Name == "" {
+			return errors.New(errNoPluginConfigRef)
+		}
+		if !hasTLS {
+			return errors.New(errNoPluginTLS)
+		}
+	default:
+		return errors.Errorf("unknown secret store type: %q", t)
+	}
+	return nil
+}
+
+func storeType(t xpv1.SecretStoreType) *xpv1.SecretStoreType {
+	return &t
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/fake"
+)
+
+func TestValidateStoreConfig(t *testing.T) {
+
+	type args struct {
+		cfg    xpv1.SecretStoreConfig
+		hasTLS bool
+	}
+
+	plugin := func(p *xpv1.PluginStoreConfig) xpv1.SecretStoreConfig {
+		return xpv1.SecretStoreConfig{Type: storeType(xpv1.SecretStorePlugin), DefaultScope: "crossplane-system", Plugin: p}
+	}
+	vault := &xpv1.PluginStoreConfig{
+		Endpoint:  "ess-plugin-vault.crossplane-system:4040",
+		ConfigRef: xpv1.Config{APIVersion: "secrets.crossplane.io/v1alpha1", Kind: "VaultConfig", Name: "vault"},
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"DefaultKubernetes": {
+			args: args{cfg: xpv1.SecretStoreConfig{DefaultScope: "crossplane-system"}},
+		},
+		"KubernetesWithoutAuthSource": {
+			args: args{cfg: xpv1.SecretStoreConfig{
+				Type:         storeType(xpv1.SecretStoreKubernetes),
+				DefaultScope: "crossplane-system",
+				Kubernetes:   &xpv1.KubernetesSecretStoreConfig{},
+			}},
+			want: errNoKubeAuthSource,
+		},
+		"PluginWithoutConfig": {
+			args: args{cfg: plugin(nil), hasTLS: true},
+			want: errNoPluginConfig,
+		},
+		"PluginWithoutEndpoint": {
+			args: args{cfg: plugin(&xpv1.PluginStoreConfig{ConfigRef: vault.ConfigRef}), hasTLS: true},
+			want: errNoPluginEndpoint,
+		},
+		"PluginWithoutConfigRef": {
+			args: args{cfg: plugin(&xpv1.PluginStoreConfig{Endpoint: vault.Endpoint}), hasTLS: true},
+			want: errNoPluginConfigRef,
+		},
+		"PluginWithoutTLS": {
+			args: args{cfg: plugin(vault)},
+			want: errNoPluginTLS,
+		},
+		"Plugin": {
+			args: args{cfg: plugin(vault), hasTLS: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ""
+			if err := ValidateStoreConfig(tc.args.cfg, tc.args.hasTLS); err != nil {
+				got = err.Error()
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestValidateStoreConfig(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestStoreConfigReconcile(t *testing.T) {
+	vault := xpv1.SecretStoreConfig{
+		Type:         storeType(xpv1.SecretStorePlugin),
+		DefaultScope: "crossplane-system",
+		Plugin: &xpv1.PluginStoreConfig{
+			Endpoint:  "ess-plugin-vault.crossplane-system:4040",
+			ConfigRef: xpv1.Config{APIVersion: "secrets.crossplane.io/v1alpha1", Kind: "VaultConfig", Name: "vault"},
+		},
+	}
+
+	type want struct {
+		result reconcile.Result
+		status *xpv1.ConditionedStatus
+		events []event.Reason
+	}
+
+	cases := map[string]struct {
+		tls        *tls.Config
+		conditions []xpv1.Condition
+		want       want
+	}{
+		"Invalid": {
+			want: want{
+				result: reconcile.Result{RequeueAfter: storeConfigRetryInterval},
+				status: xpv1.NewConditionedStatus(xpv1.Unavailable().WithMessage(errNoPluginTLS)),
+				events: []event.Reason{"ValidateStoreConfig"},
+			},
+		},
+		"StillInvalid": {
+			conditions: []xpv1.Condition{xpv1.Unavailable().WithMessage(errNoPluginTLS)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: storeConfigRetryInterval},
+			},
+		},
+		"Valid": {
+			tls: &tls.Config{},
+			want: want{
+				result: reconcile.Result{RequeueAfter: storeConfigRecheckInterval},
+				status: xpv1.NewConditionedStatus(xpv1.Available()),
+			},
+		},
+		"StillValid": {
+			tls:        &tls.Config{},
+			conditions: []xpv1.Condition{xpv1.Available()},
+			want: want{
+				result: reconcile.Result{RequeueAfter: storeConfigRecheckInterval},
+			},
+		},
+		"BecameValid": {
+			tls:        &tls.Config{},
+			conditions: []xpv1.Condition{xpv1.Unavailable().WithMessage(errNoPluginTLS)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: storeConfigRecheckInterval},
+				status: xpv1.NewConditionedStatus(xpv1.Available()),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *xpv1.ConditionedStatus
+			record := &fake.EventRecorder{}
+			r := &StoreConfigReconciler{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						sc := obj.(*v1alpha1.StoreConfig)
+						sc.Spec.SecretStoreConfig = vault
+						sc.SetConditions(tc.conditions...)
+						return nil
+					}),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+						got = obj.(*v1alpha1.StoreConfig).Status.ConditionedStatus.DeepCopy()
+						return nil
+					}),
+				},
+				tls:    tc.tls,
+				log:    logging.NewNopLogger(),
+				record: record,
+			}
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("r.Reconcile(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("r.Reconcile(...): -want result, +got result:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, got, test.EquateConditions()); diff != "" {
+				t.Errorf("r.Reconcile(...): -want status, +got status:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, record.Reasons); diff != "" {
+				t.Errorf("r.Reconcile(...): -want events, +got events:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -19,7 +19,6 @@ package dashboard
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -28,12 +27,11 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
-	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
-	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
@@ -47,6 +45,7 @@ import (
 	"github.com/crossplane-contrib/provider-newrelic/apis/dashboard/v1alpha1"
 	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
 	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
 	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
 )
 
@@ -57,26 +56,37 @@ const (
 	errGetAccountID = "cannot get accountId from ProviderConfig"
 )
 
+// Reasons of the events recorded on managed resources
+const (
+	reasonSkippedDelete event.Reason = "SkippedDelete"
+	reasonDrifted       event.Reason = "Drifted"
+)
+
 // Setup adds a controller that reconciles Dashboard.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.DashboardGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
 	}
 
+	log := o.Logger.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
-			kube:  mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
-		}),
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.DashboardKind, &connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			log:    log,
+			record: recorder,
+		})),
 		managed.WithConnectionPublishers(),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(log),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -99,8 +109,10 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
-	kube  client.Client
-	usage resource.Tracker
+	kube   client.Client
+	usage  resource.Tracker
+	log    logging.Logger
+	record event.Recorder
 }
 
 // Connect typically produces an ExternalClient by:
@@ -124,26 +136,27 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	// Get the account id from the provider config
-	accountID, err := nr.ExtractNewRelicAccountID(pc)
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create a client using NR Credentials
-	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc)
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+	return &external{dashboards: &nrClient.Dashboards, accountID: accountID, log: c.log.WithValues("name", cr.GetName()), record: c.record}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	client    *newrelic.NewRelic
-	kube      client.Client
-	accountID int
+	dashboards nr.DashboardsClient
+	accountID  int
+	log        logging.Logger
+	record     event.Recorder
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -152,6 +165,14 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotDashboard)
 	}
 
+	// Objects created before the GUID moved to the external name only have it
+	// in their spec
+	lateInitialized := false
+	if meta.GetExternalName(cr) == "" && cr.Spec.ForProvider.GUID != "" {
+		meta.SetExternalName(cr, cr.Spec.ForProvider.GUID)
+		lateInitialized = true
+	}
+
 	externalName := meta.GetExternalName(cr)
 	if externalName == "" {
 		return managed.ExternalObservation{
@@ -159,21 +180,17 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}, nil
 	}
 
-	if cr.Spec.ForProvider.GUID == "" {
-		return managed.ExternalObservation{ResourceExists: false}, nil
-	}
-
 	// Get the dashboard by GUID
-	entityGUID := common.EntityGUID(cr.Spec.ForProvider.GUID)
-	dashboard, err := c.client.Dashboards.GetDashboardEntityWithContext(ctx, entityGUID)
+	entityGUID := common.EntityGUID(externalName)
+	dashboard, err := c.dashboards.GetDashboardEntityWithContext(ctx, entityGUID)
 
 	if err != nil {
-		if strings.Contains(err.Error(), "entity not found") {
+		if nrerrors.IsNotFound(err) {
 			return managed.ExternalObservation{
 				ResourceExists: false,
 			}, nil
 		}
-		return managed.ExternalObservation{}, err
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
 	}
 
 	if dashboard.GUID == "" {
@@ -185,15 +202,26 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	// Update the status
 	cr.Status.SetConditions(xpv1.Available())
-	cr.Status.AtProvider = v1alpha1.DashboardObservation{
-		GUID: string(dashboard.GUID),
+	cr.Status.AtProvider.GUID = string(dashboard.GUID)
+	cr.Status.AtProvider.Pages = MapIDs(cr, dashboard.Pages)
+
+	upToDate, drift := IsUpToDate(cr, *dashboard)
+	if !upToDate {
+		c.log.Debug("Dashboard drifted from its spec", "fields", drift.Fields, "diff", drift.Diff)
+		// The drift is only recorded once, not on every poll until it is corrected
+		if drift.Summary() != cr.Status.AtProvider.DriftedFields {
+			c.record.Event(cr, event.Normal(reasonDrifted, "Dashboard differs from its spec in "+drift.Summary()))
+		}
 	}
+	cr.Status.AtProvider.DriftedFields = drift.Summary()
 
 	cr.SetConditions(xpv1.Available())
 	return managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  IsUpToDate(cr, *dashboard),
-		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+		Diff:                    drift.Diff,
+		ConnectionDetails:       GenerateConnectionDetails(string(dashboard.GUID), dashboard.Permalink),
 	}, nil
 }
 
@@ -207,21 +235,33 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	// Create the dashboard
 	input := GenerateDashboardInput(cr)
 
-	response, err := c.client.Dashboards.DashboardCreateWithContext(ctx, c.accountID, input)
+	response, err := c.dashboards.DashboardCreateWithContext(ctx, c.accountID, input)
 	if err != nil {
-		return managed.ExternalCreation{}, err
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
 	}
 	if len(response.Errors) > 0 {
-		return managed.ExternalCreation{}, errors.New(response.Errors[0].Description)
+		return managed.ExternalCreation{}, nrerrors.New(string(response.Errors[0].Type), response.Errors[0].Description)
 	}
 
-	// Set the ID for all pages and widgets
-	UpdateGUIDS(ctx, c, cr, response.EntityResult)
+	// Set the GUID. The IDs of the pages and widgets are mapped again on the
+	// next observation, as persisting the external name resets the status.
+	RecordIDs(cr, response.EntityResult)
 
-	// Set the GUID
 	cr.SetConditions(xpv1.Available())
 
-	return managed.ExternalCreation{}, nil
+	// The permalink is only known once the dashboard is observed
+	return managed.ExternalCreation{ConnectionDetails: GenerateConnectionDetails(string(response.EntityResult.GUID), "")}, nil
+}
+
+// GenerateConnectionDetails returns the connection details of a dashboard
+func GenerateConnectionDetails(guid, permalink string) managed.ConnectionDetails {
+	details := managed.ConnectionDetails{
+		"guid": []byte(guid),
+	}
+	if permalink != "" {
+		details["permalink"] = []byte(permalink)
+	}
+	return details
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -232,17 +272,18 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	// Create the dashboard input
 	input := GenerateDashboardInput(cr)
-	entityGUID := common.EntityGUID(cr.Spec.ForProvider.GUID)
+	entityGUID := common.EntityGUID(meta.GetExternalName(cr))
 
+	// Pages and widgets without a GUID or ID are created, so the input must
+	// carry the ones mapped to their keys or New Relic duplicates them.
 	// See - https://github.com/newrelic/newrelic-client-go/issues/802
-	// Updating is causing duplicates right now.
-	response, err := c.client.Dashboards.DashboardUpdateWithContext(ctx, input, entityGUID)
+	response, err := c.dashboards.DashboardUpdateWithContext(ctx, input, entityGUID)
 	if err != nil {
-		return managed.ExternalUpdate{}, err
+		return managed.ExternalUpdate{}, nrerrors.Classify(err)
 	}
 
 	// Set the ID for all pages and widgets
-	UpdateGUIDS(ctx, c, cr, response.EntityResult)
+	RecordIDs(cr, response.EntityResult)
 
 	cr.SetConditions(xpv1.Available())
 	return managed.ExternalUpdate{
@@ -259,77 +300,39 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	if cr.Spec.ForProvider.GUID == "" {
-		fmt.Printf("skipping delete for dashboard %s: guid must be set", cr.Spec.ForProvider.Name)
+	if meta.GetExternalName(cr) == "" {
+		c.log.Debug("Skipping delete of dashboard without a GUID")
+		c.record.Event(cr, event.Normal(reasonSkippedDelete, "Skipped deleting the dashboard from New Relic because it has no GUID"))
 		return nil
 	}
 
-	entityGUID := common.EntityGUID(cr.Spec.ForProvider.GUID)
-	_, err := c.client.Dashboards.DashboardDeleteWithContext(ctx, entityGUID)
-	if err != nil {
-		return err
-	}
-
-	return err
+	entityGUID := common.EntityGUID(meta.GetExternalName(cr))
+	_, err := c.dashboards.DashboardDeleteWithContext(ctx, entityGUID)
+	return nrerrors.IgnoreNotFound(err)
 }
 
-// IsUpToDate determines whether the Dashboard needs to be updated
-func IsUpToDate(p *v1alpha1.Dashboard, cd entities.DashboardEntity) bool {
+// IsUpToDate determines whether the Dashboard needs to be updated and returns
+// how the dashboard drifted from its spec
+func IsUpToDate(p *v1alpha1.Dashboard, cd entities.DashboardEntity) (bool, nr.Drift) {
 	// Convert both objects to the same type
 	crObject := GenerateDashboardInput(p)
 	nrObject := GenerateDashboardInputFromEntity(cd)
 
-	return cmp.Equal(crObject,
+	drift := nr.Compare(crObject,
 		nrObject,
 		cmpopts.EquateEmpty(),
-		cmpopts.IgnoreTypes(entities.DashboardWidgetRawConfiguration{}, &entities.DashboardWidgetRawConfiguration{}),
+		compareRawConfigurations,
 	)
+	return drift.UpToDate(), drift
 }
 
-// UpdateGUIDS updates all the GUIDs and IDs in our object to match what was created by the API
-func UpdateGUIDS(ctx context.Context, c *external, cr *v1alpha1.Dashboard, dashboard dashboards.DashboardEntityResult) { //nolint:gocyclo
-
-	needsKubernetesUpdate := false
-
-	// Set external name if not set
-	if meta.GetExternalName(cr) != string(dashboard.GUID) {
-		meta.SetExternalName(cr, string(dashboard.GUID))
-		needsKubernetesUpdate = true
-	}
-
-	// Set the ID for all pages and widgets
-	// Without the GUID and ID updates won't work properly and will generate duplicates
-	if cr.Spec.ForProvider.GUID != string(dashboard.GUID) {
-		cr.Spec.ForProvider.GUID = string(dashboard.GUID)
-		needsKubernetesUpdate = true
-	}
-	for p, crPage := range cr.Spec.ForProvider.Pages {
-		for _, page := range dashboard.Pages {
-			// Gross but use the page Name as unique identifier
-			if crPage.Name == page.Name {
-				if crPage.GUID != string(page.GUID) {
-					cr.Spec.ForProvider.Pages[p].GUID = string(page.GUID)
-					needsKubernetesUpdate = true
-				}
-				for w, crWidget := range crPage.Widgets {
-					for _, widget := range page.Widgets {
-						// Try to match identifiers
-						if crWidget.Title == widget.Title {
-							if crWidget.Layout.Row == widget.Layout.Row && crWidget.Layout.Width == widget.Layout.Width && crWidget.Layout.Column == widget.Layout.Column && crWidget.Layout.Height == widget.Layout.Height {
-								if pointy.StringValue(crWidget.ID, "") != widget.ID {
-									cr.Spec.ForProvider.Pages[p].Widgets[w].ID = pointy.String(widget.ID)
-									needsKubernetesUpdate = true
-								}
-							}
-						}
-					}
-				}
-			}
-		}
-	}
-	if needsKubernetesUpdate {
-		_ = c.kube.Update(ctx, cr)
-	}
+// RecordIDs records the GUID of the dashboard in its external name and maps
+// the keys of its pages and widgets to the GUIDs and IDs returned by the API.
+// The spec is left alone so that it only ever holds the desired state.
+func RecordIDs(cr *v1alpha1.Dashboard, dashboard dashboards.DashboardEntityResult) {
+	meta.SetExternalName(cr, string(dashboard.GUID))
+	cr.Status.AtProvider.GUID = string(dashboard.GUID)
+	cr.Status.AtProvider.Pages = MapIDs(cr, dashboard.Pages)
 }
 
 // GenerateDashboardInputFromEntity generates an input object
@@ -494,7 +497,8 @@ func GenerateDashboardInput(cr *v1alpha1.Dashboard) dashboards.DashboardInput {
 // GenerateDashboardPageInput generates an input object
 func GenerateDashboardPageInput(cr *v1alpha1.Dashboard) []dashboards.DashboardPageInput {
 	input := make([]dashboards.DashboardPageInput, 0)
-	for _, page := range cr.Spec.ForProvider.Pages {
+	// Use the GUIDs and IDs mapped to the keys of the pages and widgets
+	for _, page := range withIDs(cr) {
 		pageInput := dashboards.DashboardPageInput{Name: page.Name}
 		pageInput.GUID = common.EntityGUID(page.GUID)
 
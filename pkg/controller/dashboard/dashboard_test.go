@@ -17,15 +17,27 @@ limitations under the License.
 package dashboard
 
 import (
+	"context"
 	"testing"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	nrerrs "github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/pkg/errors"
 	"go.openly.dev/pointy"
 
 	"github.com/crossplane-contrib/provider-newrelic/apis/dashboard/v1alpha1"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/fake"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
 )
 
 type DashboardModifier func(dashboard *v1alpha1.Dashboard)
@@ -46,32 +58,29 @@ func Dashboard(m ...DashboardModifier) *v1alpha1.Dashboard {
 	cr := &v1alpha1.Dashboard{
 		Spec: v1alpha1.DashboardSpec{
 			ForProvider: v1alpha1.DashboardParameters{
-				AccountID: 1,
+				AccountID: pointy.Int(1),
 				Name:      "test_dashboard",
 				Variables: []v1alpha1.DashboardVariable{},
 				Pages: []v1alpha1.DashboardPage{
 					{Name: "test_dashboard_page_1",
-						GUID: "PAGE1GUID",
 						Widgets: []v1alpha1.DashboardWidget{
-							{ID: pointy.String("test_dashboard_widget_1"),
-								Title: "dashboard_title_1", Layout: v1alpha1.DashboardWidgetLayout{
-									Column: 1,
-									Height: 1,
-									Row:    1,
-									Width:  1,
-								},
+							{Title: "dashboard_title_1", Layout: v1alpha1.DashboardWidgetLayout{
+								Column: 1,
+								Height: 1,
+								Row:    1,
+								Width:  1,
+							},
 								Visualization: v1alpha1.DashboardWidgetVisualization{ID: "viz.area"},
 								RawConfiguration: &v1alpha1.DashboardWidgetRawConfiguration{
 									NRQLQueries: &[]v1alpha1.DashboardWidgetNRQLQueryInput{{AccountID: 1234567890, Query: "Select * FROM Metric"}},
 								},
 							},
-							{ID: pointy.String("test_dashboard_widget_2"),
-								Title: "dashboard_title_2", Layout: v1alpha1.DashboardWidgetLayout{
-									Column: 1,
-									Height: 1,
-									Row:    1,
-									Width:  1,
-								},
+							{Title: "dashboard_title_2", Layout: v1alpha1.DashboardWidgetLayout{
+								Column: 1,
+								Height: 1,
+								Row:    1,
+								Width:  1,
+							},
 								Visualization: v1alpha1.DashboardWidgetVisualization{ID: "viz.area"},
 								RawConfiguration: &v1alpha1.DashboardWidgetRawConfiguration{
 									NRQLQueries: &[]v1alpha1.DashboardWidgetNRQLQueryInput{{AccountID: 1234567890, Query: "Select * FROM Metric"}},
@@ -80,15 +89,13 @@ func Dashboard(m ...DashboardModifier) *v1alpha1.Dashboard {
 						},
 					},
 					{Name: "test_dashboard_page_2",
-						GUID: "PAGE1GUID",
 						Widgets: []v1alpha1.DashboardWidget{
-							{ID: pointy.String("test_dashboard_widget_1"),
-								Title: "dashboard_title_1", Layout: v1alpha1.DashboardWidgetLayout{
-									Column: 1,
-									Height: 1,
-									Row:    1,
-									Width:  1,
-								},
+							{Title: "dashboard_title_1", Layout: v1alpha1.DashboardWidgetLayout{
+								Column: 1,
+								Height: 1,
+								Row:    1,
+								Width:  1,
+							},
 								Visualization: v1alpha1.DashboardWidgetVisualization{ID: "viz.area"},
 								RawConfiguration: &v1alpha1.DashboardWidgetRawConfiguration{
 									NRQLQueries: &[]v1alpha1.DashboardWidgetNRQLQueryInput{{AccountID: 1234567890, Query: "Select * FROM Metric"}},
@@ -100,11 +107,19 @@ func Dashboard(m ...DashboardModifier) *v1alpha1.Dashboard {
 			},
 		},
 	}
-	meta.SetExternalName(cr, "1")
+	cr.Status.AtProvider.Pages = []v1alpha1.DashboardPageObservation{
+		{Key: "test_dashboard_page_1", GUID: "PAGE1GUID", Widgets: []v1alpha1.DashboardWidgetObservation{
+			{Key: "dashboard_title_1", ID: "test_dashboard_widget_1"},
+			{Key: "dashboard_title_2", ID: "test_dashboard_widget_2"},
+		}},
+		{Key: "test_dashboard_page_2", GUID: "PAGE1GUID", Widgets: []v1alpha1.DashboardWidgetObservation{
+			{Key: "dashboard_title_1", ID: "test_dashboard_widget_1"},
+		}},
+	}
+	meta.SetExternalName(cr, "1375108")
 	for _, f := range m {
 		f(cr)
 	}
-	cr.Spec.ForProvider.GUID = "1375108"
 	return cr
 }
 
@@ -112,20 +127,18 @@ func DashboardBillboard(m ...DashboardModifier) *v1alpha1.Dashboard {
 	cr := &v1alpha1.Dashboard{
 		Spec: v1alpha1.DashboardSpec{
 			ForProvider: v1alpha1.DashboardParameters{
-				AccountID: 1,
+				AccountID: pointy.Int(1),
 				Name:      "test_dashboard",
 				Variables: []v1alpha1.DashboardVariable{},
 				Pages: []v1alpha1.DashboardPage{
 					{Name: "test_dashboard_page_1",
-						GUID: "PAGE1GUID",
 						Widgets: []v1alpha1.DashboardWidget{
-							{ID: pointy.String("test_dashboard_widget_1"),
-								Title: "dashboard_title_1", Layout: v1alpha1.DashboardWidgetLayout{
-									Column: 1,
-									Height: 1,
-									Row:    1,
-									Width:  1,
-								},
+							{Title: "dashboard_title_1", Layout: v1alpha1.DashboardWidgetLayout{
+								Column: 1,
+								Height: 1,
+								Row:    1,
+								Width:  1,
+							},
 								Visualization: v1alpha1.DashboardWidgetVisualization{ID: "viz.area"},
 								RawConfiguration: &v1alpha1.DashboardWidgetRawConfiguration{
 									NRQLQueries: &[]v1alpha1.DashboardWidgetNRQLQueryInput{{AccountID: 1234567890, Query: "Select * FROM Metric"}},
@@ -140,11 +153,15 @@ func DashboardBillboard(m ...DashboardModifier) *v1alpha1.Dashboard {
 			},
 		},
 	}
-	meta.SetExternalName(cr, "1")
+	cr.Status.AtProvider.Pages = []v1alpha1.DashboardPageObservation{
+		{Key: "test_dashboard_page_1", GUID: "PAGE1GUID", Widgets: []v1alpha1.DashboardWidgetObservation{
+			{Key: "dashboard_title_1", ID: "test_dashboard_widget_1"},
+		}},
+	}
+	meta.SetExternalName(cr, "1375108")
 	for _, f := range m {
 		f(cr)
 	}
-	cr.Spec.ForProvider.GUID = "1375108"
 	return cr
 }
 
@@ -157,6 +174,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	type want struct {
 		expected bool
+		fields   []string
 	}
 
 	cases := map[string]struct {
@@ -180,7 +198,8 @@ func TestIsUpToDate(t *testing.T) {
 									Row:    1,
 									Width:  1,
 								},
-								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+								RawConfiguration: metricQuery,
 								Configuration: entities.DashboardWidgetConfiguration{
 									Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 								},
@@ -192,7 +211,8 @@ func TestIsUpToDate(t *testing.T) {
 									Row:    1,
 									Width:  1,
 								},
-								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+								RawConfiguration: metricQuery,
 								Configuration: entities.DashboardWidgetConfiguration{
 									Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 								},
@@ -211,7 +231,8 @@ func TestIsUpToDate(t *testing.T) {
 										Row:    1,
 										Width:  1,
 									},
-									Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+									Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+									RawConfiguration: metricQuery,
 									Configuration: entities.DashboardWidgetConfiguration{
 										Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 									},
@@ -221,7 +242,7 @@ func TestIsUpToDate(t *testing.T) {
 					Permissions: "PUBLIC_READ_WRITE",
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"Name"}},
 		},
 		"VariablesSame": {
 			args: args{cr: *Dashboard(withPages([]v1alpha1.DashboardPage{}),
@@ -285,7 +306,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 					},
 				}},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"Variables[0].NRQLQuery.Query"}},
 		},
 		"BillboardOutOfOrderThresholdTrue": {
 			args: args{cr: *DashboardBillboard(),
@@ -305,6 +326,8 @@ func TestIsUpToDate(t *testing.T) {
 									Width:  1,
 								},
 								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								// The thresholds are out of order
+								RawConfiguration: entities.DashboardWidgetRawConfiguration(`{"nrqlQueries":[{"accountId":1234567890,"query":"Select * FROM Metric"}],"thresholds":[{"alertSeverity":"Critical","value":90},{"alertSeverity":"Warning","value":50}]}`),
 								Configuration: entities.DashboardWidgetConfiguration{
 									Billboard: entities.DashboardBillboardWidgetConfiguration{
 										NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}},
@@ -323,7 +346,7 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{expected: true},
 		},
-		"RawConfigurationIgnoreTrue": {
+		"DiffRawConfigurationFalse": {
 			args: args{cr: *Dashboard(),
 				nr: entities.DashboardEntity{
 					Name:        "test_dashboard",
@@ -340,7 +363,8 @@ func TestIsUpToDate(t *testing.T) {
 									Row:    1,
 									Width:  1,
 								},
-								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+								RawConfiguration: metricQuery,
 								Configuration: entities.DashboardWidgetConfiguration{
 									Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 								},
@@ -352,7 +376,8 @@ func TestIsUpToDate(t *testing.T) {
 									Row:    1,
 									Width:  1,
 								},
-								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+								RawConfiguration: metricQuery,
 								Configuration: entities.DashboardWidgetConfiguration{
 									Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 								},
@@ -382,7 +407,7 @@ func TestIsUpToDate(t *testing.T) {
 					Permissions: "PUBLIC_READ_WRITE",
 				},
 			},
-			want: want{expected: true},
+			want: want{expected: false, fields: []string{"Pages[1].Widgets[0].RawConfiguration.NRQLQueries[0]"}},
 		},
 		"SameOutOfOrderTrue": {
 			args: args{cr: *Dashboard(),
@@ -401,7 +426,8 @@ func TestIsUpToDate(t *testing.T) {
 									Row:    1,
 									Width:  1,
 								},
-								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+								RawConfiguration: metricQuery,
 								Configuration: entities.DashboardWidgetConfiguration{
 									Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 								},
@@ -413,7 +439,8 @@ func TestIsUpToDate(t *testing.T) {
 									Row:    1,
 									Width:  1,
 								},
-								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+								RawConfiguration: metricQuery,
 								Configuration: entities.DashboardWidgetConfiguration{
 									Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 								},
@@ -432,7 +459,8 @@ func TestIsUpToDate(t *testing.T) {
 										Row:    1,
 										Width:  1,
 									},
-									Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+									Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+									RawConfiguration: metricQuery,
 									Configuration: entities.DashboardWidgetConfiguration{
 										Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 									},
@@ -461,7 +489,8 @@ func TestIsUpToDate(t *testing.T) {
 									Row:    1,
 									Width:  1,
 								},
-								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+								RawConfiguration: metricQuery,
 								Configuration: entities.DashboardWidgetConfiguration{
 									Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 								},
@@ -473,7 +502,8 @@ func TestIsUpToDate(t *testing.T) {
 									Row:    1,
 									Width:  1,
 								},
-								Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+								Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+								RawConfiguration: metricQuery,
 								Configuration: entities.DashboardWidgetConfiguration{
 									Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 								},
@@ -492,7 +522,8 @@ func TestIsUpToDate(t *testing.T) {
 										Row:    1,
 										Width:  1,
 									},
-									Visualization: entities.DashboardWidgetVisualization{ID: "viz.area"},
+									Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area"},
+									RawConfiguration: metricQuery,
 									Configuration: entities.DashboardWidgetConfiguration{
 										Area: entities.DashboardAreaWidgetConfiguration{NRQLQueries: []entities.DashboardWidgetNRQLQuery{{AccountID: 1234567890, Query: "Select * FROM Metric"}}},
 									},
@@ -508,10 +539,13 @@ func TestIsUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := IsUpToDate(&tc.args.cr, tc.args.nr)
+			got, drift := IsUpToDate(&tc.args.cr, tc.args.nr)
 			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
 				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
 			}
+			if diff := cmp.Diff(tc.want.fields, drift.Fields); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want fields, +got fields:\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -643,3 +677,369 @@ func TestGenerateDashboardPageInputFromEntity(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateConnectionDetails(t *testing.T) {
+
+	type args struct {
+		guid      string
+		permalink string
+	}
+
+	cases := map[string]struct {
+		args args
+		want managed.ConnectionDetails
+	}{
+		"WithPermalink": {
+			args: args{guid: "MXxWSVp8REFTSEJPQVJEfDE", permalink: "https://one.newrelic.com/redirect/entity/MXxWSVp8REFTSEJPQVJEfDE"},
+			want: managed.ConnectionDetails{
+				"guid":      []byte("MXxWSVp8REFTSEJPQVJEfDE"),
+				"permalink": []byte("https://one.newrelic.com/redirect/entity/MXxWSVp8REFTSEJPQVJEfDE"),
+			},
+		},
+		"WithoutPermalink": {
+			args: args{guid: "MXxWSVp8REFTSEJPQVJEfDE"},
+			want: managed.ConnectionDetails{
+				"guid": []byte("MXxWSVp8REFTSEJPQVJEfDE"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateConnectionDetails(tc.args.guid, tc.args.permalink)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.GenerateConnectionDetails(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+var errBoom = errors.New("boom")
+
+// metricQuery is the raw configuration New Relic returns for the widgets of
+// Dashboard(), with the defaults it adds
+var metricQuery = entities.DashboardWidgetRawConfiguration(`{"facet":{"showOtherSeries":false},"legend":{"enabled":true},"nrqlQueries":[{"accountIds":[1234567890],"query":"Select * FROM Metric"}],"platformOptions":{"ignoreTimeRange":false}}`)
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		dashboards *fake.MockDashboardsClient
+		mg         resource.Managed
+	}
+
+	type want struct {
+		o      managed.ExternalObservation
+		events []event.Reason
+		err    error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotDashboard": {
+			args: args{dashboards: &fake.MockDashboardsClient{}},
+			want: want{err: errors.New(errNotDashboard)},
+		},
+		"NoExternalName": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{},
+				mg:         Dashboard(func(d *v1alpha1.Dashboard) { meta.SetExternalName(d, "") }),
+			},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"Exists": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockGetDashboardEntityWithContext: func(_ context.Context, guid common.EntityGUID) (*entities.DashboardEntity, error) {
+						return &entities.DashboardEntity{GUID: guid, Name: "test_dashboard", Permalink: "https://one.newrelic.com/d"}, nil
+					},
+				},
+				mg: Dashboard(),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ConnectionDetails: GenerateConnectionDetails("1375108", "https://one.newrelic.com/d")},
+				events: []event.Reason{reasonDrifted},
+			},
+		},
+		"DriftAlreadyRecorded": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockGetDashboardEntityWithContext: func(_ context.Context, guid common.EntityGUID) (*entities.DashboardEntity, error) {
+						return &entities.DashboardEntity{GUID: guid, Name: "test_dashboard", Permalink: "https://one.newrelic.com/d"}, nil
+					},
+				},
+				mg: Dashboard(func(d *v1alpha1.Dashboard) { d.Status.AtProvider.DriftedFields = "Pages[0], Pages[1], Permissions" }),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: GenerateConnectionDetails("1375108", "https://one.newrelic.com/d")},
+			},
+		},
+		"DriftChanged": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockGetDashboardEntityWithContext: func(_ context.Context, guid common.EntityGUID) (*entities.DashboardEntity, error) {
+						return &entities.DashboardEntity{GUID: guid, Name: "test_dashboard", Permalink: "https://one.newrelic.com/d"}, nil
+					},
+				},
+				mg: Dashboard(func(d *v1alpha1.Dashboard) { d.Status.AtProvider.DriftedFields = "Permissions" }),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ConnectionDetails: GenerateConnectionDetails("1375108", "https://one.newrelic.com/d")},
+				events: []event.Reason{reasonDrifted},
+			},
+		},
+		"GUIDInSpec": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockGetDashboardEntityWithContext: func(_ context.Context, guid common.EntityGUID) (*entities.DashboardEntity, error) {
+						return &entities.DashboardEntity{GUID: guid, Name: "test_dashboard", Permalink: "https://one.newrelic.com/d"}, nil
+					},
+				},
+				mg: Dashboard(func(d *v1alpha1.Dashboard) {
+					meta.SetExternalName(d, "")
+					d.Spec.ForProvider.GUID = "OLDGUID"
+				}),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ResourceLateInitialized: true, ConnectionDetails: GenerateConnectionDetails("OLDGUID", "https://one.newrelic.com/d")},
+				events: []event.Reason{reasonDrifted},
+			},
+		},
+		"NotFound": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockGetDashboardEntityWithContext: func(_ context.Context, _ common.EntityGUID) (*entities.DashboardEntity, error) {
+						return nil, nrerrs.NewNotFound("resource not found")
+					},
+				},
+				mg: Dashboard(),
+			},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"GetFailed": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockGetDashboardEntityWithContext: func(_ context.Context, _ common.EntityGUID) (*entities.DashboardEntity, error) {
+						return nil, errBoom
+					},
+				},
+				mg: Dashboard(),
+			},
+			want: want{err: nrerrors.Classify(errBoom)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			record := &fake.EventRecorder{}
+			e := external{dashboards: tc.args.dashboards, accountID: 1, log: logging.NewNopLogger(), record: record}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			// Whether the dashboard is up to date is covered by TestIsUpToDate
+			if diff := cmp.Diff(tc.want.o, got, cmpopts.IgnoreFields(managed.ExternalObservation{}, "ResourceUpToDate", "Diff")); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, record.Reasons); diff != "" {
+				t.Errorf("e.Observe(...): -want events, +got events:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		dashboards *fake.MockDashboardsClient
+		mg         resource.Managed
+	}
+
+	type want struct {
+		o    managed.ExternalCreation
+		guid string
+		err  error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotDashboard": {
+			args: args{dashboards: &fake.MockDashboardsClient{}},
+			want: want{err: errors.New(errNotDashboard)},
+		},
+		"Created": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockDashboardCreateWithContext: func(_ context.Context, _ int, _ dashboards.DashboardInput) (*dashboards.DashboardCreateResult, error) {
+						return &dashboards.DashboardCreateResult{EntityResult: dashboards.DashboardEntityResult{GUID: "NEWGUID"}}, nil
+					},
+				},
+				mg: Dashboard(),
+			},
+			want: want{o: managed.ExternalCreation{ConnectionDetails: GenerateConnectionDetails("NEWGUID", "")}, guid: "NEWGUID"},
+		},
+		"CreateFailed": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockDashboardCreateWithContext: func(_ context.Context, _ int, _ dashboards.DashboardInput) (*dashboards.DashboardCreateResult, error) {
+						return nil, errBoom
+					},
+				},
+				mg: Dashboard(),
+			},
+			want: want{guid: "1375108", err: nrerrors.Classify(errBoom)},
+		},
+		"CreateRejected": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockDashboardCreateWithContext: func(_ context.Context, _ int, _ dashboards.DashboardInput) (*dashboards.DashboardCreateResult, error) {
+						return &dashboards.DashboardCreateResult{Errors: []dashboards.DashboardCreateError{{Description: "invalid widget"}}}, nil
+					},
+				},
+				mg: Dashboard(),
+			},
+			want: want{guid: "1375108", err: nrerrors.Classify(errors.New("invalid widget"))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{dashboards: tc.args.dashboards, accountID: 1}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.Dashboard); ok {
+				if diff := cmp.Diff(tc.want.guid, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("e.Create(...): -want guid, +got guid:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		dashboards *fake.MockDashboardsClient
+		mg         resource.Managed
+	}
+
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"NotDashboard": {
+			args: args{dashboards: &fake.MockDashboardsClient{}},
+			want: errors.New(errNotDashboard),
+		},
+		"Updated": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockDashboardUpdateWithContext: func(_ context.Context, _ dashboards.DashboardInput, guid common.EntityGUID) (*dashboards.DashboardUpdateResult, error) {
+						return &dashboards.DashboardUpdateResult{EntityResult: dashboards.DashboardEntityResult{GUID: guid}}, nil
+					},
+				},
+				mg: Dashboard(),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockDashboardUpdateWithContext: func(_ context.Context, _ dashboards.DashboardInput, _ common.EntityGUID) (*dashboards.DashboardUpdateResult, error) {
+						return nil, errBoom
+					},
+				},
+				mg: Dashboard(),
+			},
+			want: nrerrors.Classify(errBoom),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{dashboards: tc.args.dashboards, accountID: 1}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		dashboards *fake.MockDashboardsClient
+		mg         resource.Managed
+	}
+
+	cases := map[string]struct {
+		args   args
+		want   error
+		events []event.Reason
+	}{
+		"NotDashboard": {
+			args: args{dashboards: &fake.MockDashboardsClient{}},
+			want: errors.New(errNotDashboard),
+		},
+		"NoGUID": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{},
+				mg: func() resource.Managed {
+					cr := Dashboard()
+					meta.SetExternalName(cr, "")
+					return cr
+				}(),
+			},
+			events: []event.Reason{reasonSkippedDelete},
+		},
+		"Deleted": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockDashboardDeleteWithContext: func(_ context.Context, _ common.EntityGUID) (*dashboards.DashboardDeleteResult, error) {
+						return &dashboards.DashboardDeleteResult{Status: dashboards.DashboardDeleteResultStatusTypes.SUCCESS}, nil
+					},
+				},
+				mg: Dashboard(),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockDashboardDeleteWithContext: func(_ context.Context, _ common.EntityGUID) (*dashboards.DashboardDeleteResult, error) {
+						return nil, nrerrs.NewNotFound("resource not found")
+					},
+				},
+				mg: Dashboard(),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				dashboards: &fake.MockDashboardsClient{
+					MockDashboardDeleteWithContext: func(_ context.Context, _ common.EntityGUID) (*dashboards.DashboardDeleteResult, error) {
+						return nil, errBoom
+					},
+				},
+				mg: Dashboard(),
+			},
+			want: nrerrors.Classify(errBoom),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			record := &fake.EventRecorder{}
+			e := external{dashboards: tc.args.dashboards, accountID: 1, log: logging.NewNopLogger(), record: record}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.events, record.Reasons); diff != "" {
+				t.Errorf("e.Delete(...): -want events, +got events:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"fmt"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/dashboard/v1alpha1"
+)
+
+// pageKeys returns the keys of pages, which default to their names. A page
+// renamed without a key keeps its previous key, see carryKeys.
+func pageKeys(pages []v1alpha1.DashboardPage, previous []v1alpha1.DashboardPageObservation) []string {
+	keys := make([]string, len(pages))
+	explicit := make([]bool, len(pages))
+	for i, page := range pages {
+		keys[i] = page.Key
+		explicit[i] = page.Key != ""
+		if keys[i] == "" {
+			keys[i] = page.Name
+		}
+	}
+	prev := make([]string, len(previous))
+	for i, page := range previous {
+		prev[i] = page.Key
+	}
+	return carryKeys(uniqueKeys(keys), explicit, prev)
+}
+
+// widgetKeys returns the keys of widgets, which default to their titles. A
+// widget retitled without a key keeps its previous key, see carryKeys.
+func widgetKeys(widgets []v1alpha1.DashboardWidget, previous []v1alpha1.DashboardWidgetObservation) []string {
+	keys := make([]string, len(widgets))
+	explicit := make([]bool, len(widgets))
+	for i, widget := range widgets {
+		keys[i] = widget.Key
+		explicit[i] = widget.Key != ""
+		if keys[i] == "" {
+			keys[i] = widget.Title
+		}
+	}
+	prev := make([]string, len(previous))
+	for i, widget := range previous {
+		prev[i] = widget.Key
+	}
+	return carryKeys(uniqueKeys(keys), explicit, prev)
+}
+
+// uniqueKeys tells apart the items sharing a key by their position among
+// them, e.g. the second widget titled Errors is keyed Errors#2
+func uniqueKeys(keys []string) []string {
+	seen := map[string]int{}
+	for i, key := range keys {
+		seen[key]++
+		if n := seen[key]; n > 1 {
+			keys[i] = fmt.Sprintf("%s#%d", key, n)
+		}
+	}
+	return keys
+}
+
+// carryKeys keeps the previous keys of items renamed without a key, so that
+// they are still edited in place. An item whose default key is new takes the
+// previous key at its position, unless another item still has it. Items that
+// are renamed and moved at once, or keyed explicitly, are not carried.
+func carryKeys(keys []string, explicit []bool, previous []string) []string {
+	used := map[string]bool{}
+	for _, key := range keys {
+		used[key] = true
+	}
+	known := map[string]bool{}
+	for _, key := range previous {
+		known[key] = true
+	}
+	for i, key := range keys {
+		if explicit[i] || known[key] || i >= len(previous) || used[previous[i]] {
+			continue
+		}
+		keys[i] = previous[i]
+		used[previous[i]] = true
+	}
+	return keys
+}
+
+// claim returns the first item not taken yet that matches, or -1 if there is
+// none, and takes it
+func claim(taken []bool, matches func(i int) bool) int {
+	for i := range taken {
+		if !taken[i] && matches(i) {
+			taken[i] = true
+			return i
+		}
+	}
+	return -1
+}
+
+// MapIDs maps the keys of the pages and widgets of a dashboard to the
+// identifiers of the observed ones. A key keeps its identifier for as long as
+// it exists, so that renaming or moving what it keys is an edit in place. A
+// key without one takes the deprecated identifier in its spec, if any, which
+// migrates objects created before the identifiers moved to the status. Other
+// new keys claim the page with the same name, or the widget with the same
+// title and layout, that no other key has.
+func MapIDs(cr *v1alpha1.Dashboard, observed []entities.DashboardPage) []v1alpha1.DashboardPageObservation {
+	known := map[string]v1alpha1.DashboardPageObservation{}
+	for _, page := range cr.Status.AtProvider.Pages {
+		known[page.Key] = page
+	}
+
+	pages := cr.Spec.ForProvider.Pages
+	keys := pageKeys(pages, cr.Status.AtProvider.Pages)
+	taken := make([]bool, len(observed))
+	matched := make([]int, len(pages))
+	for i, key := range keys {
+		guid := known[key].GUID
+		if guid == "" {
+			guid = pages[i].GUID
+		}
+		matched[i] = claim(taken, func(j int) bool { return guid != "" && string(observed[j].GUID) == guid })
+	}
+	for i, page := range pages {
+		if matched[i] < 0 {
+			matched[i] = claim(taken, func(j int) bool { return observed[j].Name == page.Name })
+		}
+	}
+
+	var ids []v1alpha1.DashboardPageObservation
+	for i, j := range matched {
+		if j < 0 {
+			continue
+		}
+		ids = append(ids, v1alpha1.DashboardPageObservation{
+			Key:     keys[i],
+			GUID:    string(observed[j].GUID),
+			Widgets: mapWidgetIDs(pages[i].Widgets, known[keys[i]].Widgets, observed[j].Widgets),
+		})
+	}
+	return ids
+}
+
+// mapWidgetIDs maps the keys of the widgets of a page to the identifiers of
+// the observed ones
+func mapWidgetIDs(widgets []v1alpha1.DashboardWidget, mapped []v1alpha1.DashboardWidgetObservation, observed []entities.DashboardWidget) []v1alpha1.DashboardWidgetObservation {
+	known := map[string]string{}
+	for _, widget := range mapped {
+		known[widget.Key] = widget.ID
+	}
+
+	keys := widgetKeys(widgets, mapped)
+	taken := make([]bool, len(observed))
+	matched := make([]int, len(widgets))
+	for i, key := range keys {
+		id, ok := known[key]
+		if !ok {
+			id = pointy.StringValue(widgets[i].ID, "")
+		}
+		matched[i] = claim(taken, func(j int) bool { return id != "" && observed[j].ID == id })
+	}
+	for i, widget := range widgets {
+		if matched[i] < 0 {
+			matched[i] = claim(taken, func(j int) bool {
+				return observed[j].Title == widget.Title && GenerateDashboardWidgetLayoutInputFromEntity(observed[j].Layout) == GenerateDashboardWidgetLayoutInput(widget.Layout)
+			})
+		}
+	}
+
+	var ids []v1alpha1.DashboardWidgetObservation
+	for i, j := range matched {
+		if j >= 0 {
+			ids = append(ids, v1alpha1.DashboardWidgetObservation{Key: keys[i], ID: observed[j].ID})
+		}
+	}
+	return ids
+}
+
+// withIDs returns the pages of a dashboard with the identifiers mapped to
+// their keys, so that updating them edits them in place
+func withIDs(cr *v1alpha1.Dashboard) []v1alpha1.DashboardPage {
+	known := map[string]v1alpha1.DashboardPageObservation{}
+	for _, page := range cr.Status.AtProvider.Pages {
+		known[page.Key] = page
+	}
+
+	pages := make([]v1alpha1.DashboardPage, len(cr.Spec.ForProvider.Pages))
+	for i, key := range pageKeys(cr.Spec.ForProvider.Pages, cr.Status.AtProvider.Pages) {
+		pages[i] = *cr.Spec.ForProvider.Pages[i].DeepCopy()
+		// The deprecated GUIDs and IDs of the spec are only used to map keys
+		// that have none yet
+		ids := known[key]
+		pages[i].GUID = ids.GUID
+		widgets := map[string]string{}
+		for _, widget := range ids.Widgets {
+			widgets[widget.Key] = widget.ID
+		}
+		for j, key := range widgetKeys(pages[i].Widgets, ids.Widgets) {
+			pages[i].Widgets[j].ID = nil
+			if id, ok := widgets[key]; ok {
+				pages[i].Widgets[j].ID = pointy.String(id)
+			}
+		}
+	}
+	return pages
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/dashboard/v1alpha1"
+)
+
+func keyedDashboard(pages []v1alpha1.DashboardPage, ids []v1alpha1.DashboardPageObservation) *v1alpha1.Dashboard {
+	cr := &v1alpha1.Dashboard{}
+	cr.Spec.ForProvider.Pages = pages
+	cr.Status.AtProvider.Pages = ids
+	return cr
+}
+
+func TestMapIDs(t *testing.T) {
+	layout := v1alpha1.DashboardWidgetLayout{Column: 1, Row: 1, Width: 4, Height: 3}
+	moved := v1alpha1.DashboardWidgetLayout{Column: 5, Row: 1, Width: 4, Height: 3}
+	observedLayout := entities.DashboardWidgetLayout{Column: 1, Row: 1, Width: 4, Height: 3}
+
+	cases := map[string]struct {
+		cr       *v1alpha1.Dashboard
+		observed []entities.DashboardPage
+		want     []v1alpha1.DashboardPageObservation
+	}{
+		"NewByNameAndLayout": {
+			cr: keyedDashboard([]v1alpha1.DashboardPage{{Name: "Overview", Widgets: []v1alpha1.DashboardWidget{
+				{Title: "Errors", Layout: layout},
+				{Title: "Errors", Layout: moved},
+			}}}, nil),
+			observed: []entities.DashboardPage{{GUID: "PAGE", Name: "Overview", Widgets: []entities.DashboardWidget{
+				{ID: "1", Title: "Errors", Layout: entities.DashboardWidgetLayout{Column: 5, Row: 1, Width: 4, Height: 3}},
+				{ID: "2", Title: "Errors", Layout: observedLayout},
+			}}},
+			want: []v1alpha1.DashboardPageObservation{{Key: "Overview", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidgetObservation{
+				{Key: "Errors", ID: "2"},
+				{Key: "Errors#2", ID: "1"},
+			}}},
+		},
+		"RenamedAndMovedByKey": {
+			cr: keyedDashboard([]v1alpha1.DashboardPage{{Key: "main", Name: "Renamed", Widgets: []v1alpha1.DashboardWidget{
+				{Key: "errors", Title: "Error rate", Layout: moved},
+			}}}, []v1alpha1.DashboardPageObservation{{Key: "main", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidgetObservation{
+				{Key: "errors", ID: "1"},
+			}}}),
+			observed: []entities.DashboardPage{{GUID: "PAGE", Name: "Overview", Widgets: []entities.DashboardWidget{
+				{ID: "1", Title: "Errors", Layout: observedLayout},
+			}}},
+			want: []v1alpha1.DashboardPageObservation{{Key: "main", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidgetObservation{
+				{Key: "errors", ID: "1"},
+			}}},
+		},
+		"RenamedWithoutKey": {
+			cr: keyedDashboard([]v1alpha1.DashboardPage{{Name: "Summary", Widgets: []v1alpha1.DashboardWidget{
+				{Title: "Error rate", Layout: layout},
+			}}}, []v1alpha1.DashboardPageObservation{{Key: "Overview", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidgetObservation{
+				{Key: "Errors", ID: "1"},
+			}}}),
+			observed: []entities.DashboardPage{{GUID: "PAGE", Name: "Overview", Widgets: []entities.DashboardWidget{
+				{ID: "1", Title: "Errors", Layout: observedLayout},
+			}}},
+			want: []v1alpha1.DashboardPageObservation{{Key: "Overview", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidgetObservation{
+				{Key: "Errors", ID: "1"},
+			}}},
+		},
+		"InsertedWithoutKey": {
+			cr:       keyedDashboard([]v1alpha1.DashboardPage{{Name: "Details"}, {Name: "Overview"}}, []v1alpha1.DashboardPageObservation{{Key: "Overview", GUID: "PAGE"}}),
+			observed: []entities.DashboardPage{{GUID: "PAGE", Name: "Overview"}},
+			want:     []v1alpha1.DashboardPageObservation{{Key: "Overview", GUID: "PAGE"}},
+		},
+		"FromSpec": {
+			cr: keyedDashboard([]v1alpha1.DashboardPage{{Name: "Renamed", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidget{
+				{ID: pointy.String("1"), Title: "Error rate", Layout: moved},
+			}}}, nil),
+			observed: []entities.DashboardPage{{GUID: "PAGE", Name: "Overview", Widgets: []entities.DashboardWidget{
+				{ID: "1", Title: "Errors", Layout: observedLayout},
+			}}},
+			want: []v1alpha1.DashboardPageObservation{{Key: "Renamed", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidgetObservation{
+				{Key: "Error rate", ID: "1"},
+			}}},
+		},
+		"Removed": {
+			cr:       keyedDashboard([]v1alpha1.DashboardPage{{Key: "main", Name: "Overview"}}, []v1alpha1.DashboardPageObservation{{Key: "main", GUID: "DELETED"}}),
+			observed: []entities.DashboardPage{{GUID: "PAGE", Name: "Overview"}},
+			want:     []v1alpha1.DashboardPageObservation{{Key: "main", GUID: "PAGE"}},
+		},
+		"NotCreated": {
+			cr:       keyedDashboard([]v1alpha1.DashboardPage{{Name: "Overview"}, {Name: "Details"}}, nil),
+			observed: []entities.DashboardPage{{GUID: "PAGE", Name: "Overview"}},
+			want:     []v1alpha1.DashboardPageObservation{{Key: "Overview", GUID: "PAGE"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := MapIDs(tc.cr, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MapIDs(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDashboardPageInputWithIDs(t *testing.T) {
+	cr := keyedDashboard([]v1alpha1.DashboardPage{{Key: "main", Name: "Renamed", Widgets: []v1alpha1.DashboardWidget{
+		{Key: "errors", Title: "Error rate"},
+		{Title: "New", ID: pointy.String("DEPRECATED")},
+	}}}, []v1alpha1.DashboardPageObservation{{Key: "main", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidgetObservation{
+		{Key: "errors", ID: "1"},
+	}}})
+
+	got := GenerateDashboardPageInput(cr)
+	if diff := cmp.Diff("PAGE", string(got[0].GUID)); diff != "" {
+		t.Errorf("GenerateDashboardPageInput(...): -want page GUID, +got page GUID:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"", "1"}, []string{got[0].Widgets[0].ID, got[0].Widgets[1].ID}); diff != "" {
+		t.Errorf("GenerateDashboardPageInput(...): -want widget IDs, +got widget IDs:\n%s\n", diff)
+	}
+	if cr.Spec.ForProvider.Pages[0].GUID != "" || cr.Spec.ForProvider.Pages[0].Widgets[0].ID != nil || *cr.Spec.ForProvider.Pages[0].Widgets[1].ID != "DEPRECATED" {
+		t.Errorf("GenerateDashboardPageInput(...): want the spec left unchanged, got %+v", cr.Spec.ForProvider.Pages[0])
+	}
+}
+
+func TestGenerateDashboardPageInputRenamedWithoutKey(t *testing.T) {
+	cr := keyedDashboard([]v1alpha1.DashboardPage{{Name: "Summary", Widgets: []v1alpha1.DashboardWidget{
+		{Title: "Error rate"},
+		{Title: "Throughput"},
+	}}}, []v1alpha1.DashboardPageObservation{{Key: "Overview", GUID: "PAGE", Widgets: []v1alpha1.DashboardWidgetObservation{
+		{Key: "Errors", ID: "1"},
+		{Key: "Throughput", ID: "2"},
+	}}})
+
+	got := GenerateDashboardPageInput(cr)
+	if diff := cmp.Diff("PAGE", string(got[0].GUID)); diff != "" {
+		t.Errorf("GenerateDashboardPageInput(...): -want page GUID, +got page GUID:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"1", "2"}, []string{got[0].Widgets[0].ID, got[0].Widgets[1].ID}); diff != "" {
+		t.Errorf("GenerateDashboardPageInput(...): -want widget IDs, +got widget IDs:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"go.openly.dev/pointy"
+)
+
+// RawConfiguration is the part of a widget's raw configuration that a
+// Dashboard manages, normalised so that configurations which only differ in
+// formatting or in the defaults New Relic adds compare equal.
+type RawConfiguration struct {
+	NRQLQueries     []RawNRQLQuery
+	IgnoreTimeRange bool
+	Limit           float64
+	Text            string
+	Thresholds      []RawThreshold
+
+	// Invalid is a raw configuration that is not a JSON object, kept as is so
+	// that it still differs from any valid one
+	Invalid string
+}
+
+// RawNRQLQuery is a normalised NRQL query of a widget
+type RawNRQLQuery struct {
+	AccountIDs []int
+	Query      string
+}
+
+// RawThreshold is a normalised billboard threshold
+type RawThreshold struct {
+	AlertSeverity string
+	Value         float64
+}
+
+// rawConfigurationJSON is the raw configuration as either the spec or New
+// Relic writes it. Settings the spec has no field for, like the legend or the
+// axes New Relic adds to charts, are left out.
+type rawConfigurationJSON struct {
+	NRQLQueries []struct {
+		AccountID int `json:"accountId"`
+		// New Relic may return the accounts of a query as a list
+		AccountIDs []int  `json:"accountIds"`
+		Query      string `json:"query"`
+	} `json:"nrqlQueries"`
+	PlatformOptions *struct {
+		IgnoreTimeRange bool `json:"ignoreTimeRange"`
+	} `json:"platformOptions"`
+	Limit *float64 `json:"limit"`
+	Text  *string  `json:"text"`
+	// Billboards take a list of thresholds, while charts configured in the UI
+	// take an object the spec cannot express
+	Thresholds json.RawMessage `json:"thresholds"`
+}
+
+// NormalizeRawConfiguration returns the managed part of a raw configuration
+func NormalizeRawConfiguration(raw entities.DashboardWidgetRawConfiguration) RawConfiguration {
+	if len(raw) == 0 || string(raw) == "null" {
+		return RawConfiguration{}
+	}
+	in := rawConfigurationJSON{}
+	if err := json.Unmarshal(raw, &in); err != nil {
+		return RawConfiguration{Invalid: string(raw)}
+	}
+
+	out := RawConfiguration{
+		Limit: pointy.Float64Value(in.Limit, 0),
+		Text:  strings.TrimSpace(pointy.StringValue(in.Text, "")),
+	}
+	if in.PlatformOptions != nil {
+		out.IgnoreTimeRange = in.PlatformOptions.IgnoreTimeRange
+	}
+	for _, q := range in.NRQLQueries {
+		out.NRQLQueries = append(out.NRQLQueries, RawNRQLQuery{AccountIDs: queryAccountIDs(q.AccountID, q.AccountIDs), Query: strings.TrimSpace(q.Query)})
+	}
+	// The order of the thresholds does not matter
+	thresholds := []struct {
+		AlertSeverity *string  `json:"alertSeverity"`
+		Value         *float64 `json:"value"`
+	}{}
+	if json.Unmarshal(in.Thresholds, &thresholds) == nil {
+		for _, t := range thresholds {
+			out.Thresholds = append(out.Thresholds, RawThreshold{AlertSeverity: pointy.StringValue(t.AlertSeverity, ""), Value: pointy.Float64Value(t.Value, 0)})
+		}
+		sort.Slice(out.Thresholds, func(i, j int) bool {
+			if a, b := out.Thresholds[i].Value, out.Thresholds[j].Value; a != b {
+				return a < b
+			}
+			return out.Thresholds[i].AlertSeverity < out.Thresholds[j].AlertSeverity
+		})
+	}
+	return out
+}
+
+// queryAccountIDs returns the sorted accounts a query runs in, from its
+// accountId, its accountIds or both. A query New Relic runs in more accounts
+// than the spec names differs from it.
+func queryAccountIDs(accountID int, accountIDs []int) []int {
+	seen := map[int]bool{}
+	ids := []int{}
+	for _, id := range append([]int{accountID}, accountIDs...) {
+		if id != 0 && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// compareRawConfigurations compares raw configurations by their managed part
+var compareRawConfigurations = cmp.Transformer("NormalizeRawConfiguration", NormalizeRawConfiguration)
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dashboard
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/dashboard/v1alpha1"
+)
+
+func TestRawConfigurationsAreEqual(t *testing.T) {
+	query := &[]v1alpha1.DashboardWidgetNRQLQueryInput{{AccountID: 1234567890, Query: "SELECT count(*) FROM Transaction"}}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.DashboardWidgetRawConfiguration
+		nr   string
+		want bool
+	}{
+		"ChartWithDefaults": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":false},"legend":{"enabled":true},"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":false},"yAxisLeft":{"zero":true}}`,
+			want: true,
+		},
+		"ChartQueryChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountId":1234567890,"query":"SELECT count(*) FROM Transaction FACET appName"}]}`,
+			want: false,
+		},
+		"ChartAccountChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountId":1,"query":"SELECT count(*) FROM Transaction"}]}`,
+			want: false,
+		},
+		"ChartAccountAndAccounts": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountId":1234567890,"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}]}`,
+			want: true,
+		},
+		"ChartAccountAdded": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountIds":[1234567890,1],"query":"SELECT count(*) FROM Transaction"}]}`,
+			want: false,
+		},
+		"ChartAccountsReplaced": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountIds":[1],"query":"SELECT count(*) FROM Transaction"}]}`,
+			want: false,
+		},
+		"ChartQueryAdded": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountId":1234567890,"query":"SELECT count(*) FROM Transaction"},{"accountId":1234567890,"query":"SELECT count(*) FROM PageView"}]}`,
+			want: false,
+		},
+		"ChartUIThresholds": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountId":1234567890,"query":"SELECT count(*) FROM Transaction"}],"thresholds":{"isLabelVisible":true,"thresholds":[{"from":1,"severity":"warning"}]}}`,
+			want: true,
+		},
+		"IgnoreTimeRangeChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query, PlatformOptions: &v1alpha1.RawConfigurationPlatformOptions{IgnoreTimeRange: true}},
+			nr:   `{"nrqlQueries":[{"accountId":1234567890,"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: false,
+		},
+		"AreaWithDefaults": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":false},"legend":{"enabled":true},"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: true,
+		},
+		"BarWithDefaults": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":false},"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: true,
+		},
+		"BarQueryChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":false},"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction FACET host"}]}`,
+			want: false,
+		},
+		"TableWithDefaults": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":false},"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: true,
+		},
+		"TableQueryChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":false},"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction LIMIT 100"}],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: false,
+		},
+		"TableIgnoreTimeRangeChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":false},"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":true}}`,
+			want: false,
+		},
+		"PieWithDefaults": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":true},"legend":{"enabled":true},"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: true,
+		},
+		"PieAccountAdded": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"facet":{"showOtherSeries":true},"legend":{"enabled":true},"nrqlQueries":[{"accountIds":[1,1234567890],"query":"SELECT count(*) FROM Transaction"}]}`,
+			want: false,
+		},
+		"JSONWithDefaults": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: true,
+		},
+		"JSONQueryRemoved": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: false,
+		},
+		"HeatmapWithDefaults": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT count(*) FROM Transaction"}],"platformOptions":{"ignoreTimeRange":false}}`,
+			want: true,
+		},
+		"HeatmapQueryChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `{"nrqlQueries":[{"accountIds":[1234567890],"query":"SELECT histogram(duration) FROM Transaction"}]}`,
+			want: false,
+		},
+		"BulletSameLimit": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query, Limit: pointy.Float64(100)},
+			nr:   `{"limit":100.0,"nrqlQueries":[{"accountId":1234567890,"query":"SELECT count(*) FROM Transaction"}]}`,
+			want: true,
+		},
+		"BulletLimitChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query, Limit: pointy.Float64(100)},
+			nr:   `{"limit":50,"nrqlQueries":[{"accountId":1234567890,"query":"SELECT count(*) FROM Transaction"}]}`,
+			want: false,
+		},
+		"MarkdownSameText": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{Text: pointy.String("# Runbook\n")},
+			nr:   `{"text":"# Runbook"}`,
+			want: true,
+		},
+		"MarkdownTextChanged": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{Text: pointy.String("# Runbook")},
+			nr:   `{"text":"# Edited in the UI"}`,
+			want: false,
+		},
+		"BillboardThresholdsOutOfOrder": {
+			cr: &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query, Thresholds: []v1alpha1.DashboardBillboardWidgetThresholdInput{
+				{AlertSeverity: pointy.String("WARNING"), Value: pointy.Float64(50)},
+				{AlertSeverity: pointy.String("CRITICAL"), Value: pointy.Float64(90)},
+			}},
+			nr:   `{"nrqlQueries":[{"accountId":1234567890,"query":"SELECT count(*) FROM Transaction"}],"thresholds":[{"alertSeverity":"CRITICAL","value":90},{"alertSeverity":"WARNING","value":50}]}`,
+			want: true,
+		},
+		"BillboardThresholdChanged": {
+			cr: &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query, Thresholds: []v1alpha1.DashboardBillboardWidgetThresholdInput{
+				{AlertSeverity: pointy.String("CRITICAL"), Value: pointy.Float64(90)},
+			}},
+			nr:   `{"nrqlQueries":[{"accountId":1234567890,"query":"SELECT count(*) FROM Transaction"}],"thresholds":[{"alertSeverity":"CRITICAL","value":80}]}`,
+			want: false,
+		},
+		"NoneEmpty": {
+			nr:   `{}`,
+			want: true,
+		},
+		"Invalid": {
+			cr:   &v1alpha1.DashboardWidgetRawConfiguration{NRQLQueries: query},
+			nr:   `not json`,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr, err := GenerateDashboardWidgetRawConfigurationInput(tc.cr)
+			if err != nil {
+				t.Fatalf("GenerateDashboardWidgetRawConfigurationInput(...): %v", err)
+			}
+			got := cmp.Equal(cr, entities.DashboardWidgetRawConfiguration(tc.nr), compareRawConfigurations)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("cmp.Equal(...): -want, +got:\n%s\n%s", diff, cmp.Diff(cr, entities.DashboardWidgetRawConfiguration(tc.nr), compareRawConfigurations))
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package entitylookup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/entitylookup/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotEntityLookup = "managed resource is not an EntityLookup custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errGetPC           = "cannot get ProviderConfig"
+	errNoMatch         = "no entity matches the lookup"
+)
+
+// maxGUIDs bounds the number of GUIDs kept in the status
+const maxGUIDs = 100
+
+// Setup adds a controller that reconciles EntityLookup.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.EntityLookupGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.EntityLookupKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EntityLookupGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.EntityLookup{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.EntityLookup)
+	if !ok {
+		return nil, errors.New(errNotEntityLookup)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{entities: &nrClient.Entities, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	entities  nr.EntitiesClient
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EntityLookup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEntityLookup)
+	}
+
+	// The search is run on every poll so the GUIDs follow the entities
+	search, err := c.entities.GetEntitySearchByQueryWithContext(ctx, entities.EntitySearchOptions{}, GenerateQuery(cr.Spec.ForProvider), []entities.EntitySearchSortCriteria{})
+	if err != nil {
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
+	}
+	guids := MatchingGUIDs(cr.Spec.ForProvider, search.Results.Entities)
+
+	// Update the status
+	cr.Status.AtProvider = v1alpha1.EntityLookupObservation{Count: len(guids)}
+	if len(guids) > maxGUIDs {
+		guids = guids[:maxGUIDs]
+	}
+	cr.Status.AtProvider.GUIDs = guids
+
+	details := managed.ConnectionDetails{}
+	if len(guids) == 0 {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(errNoMatch))
+	} else {
+		cr.Status.AtProvider.GUID = guids[0]
+		details["guid"] = []byte(guids[0])
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: details,
+	}, nil
+}
+
+// An EntityLookup only reads from New Relic, so there is nothing to create, update or delete.
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.EntityLookup)
+	if !ok {
+		return errors.New(errNotEntityLookup)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// GenerateQuery builds the entity search query of the lookup filters
+func GenerateQuery(p v1alpha1.EntityLookupParameters) string {
+	var conditions []string
+	if p.Name != nil {
+		conditions = append(conditions, fmt.Sprintf("name = '%s'", quote(*p.Name)))
+	}
+	if p.Domain != nil {
+		conditions = append(conditions, fmt.Sprintf("domain = '%s'", quote(*p.Domain)))
+	}
+	if p.Type != nil {
+		conditions = append(conditions, fmt.Sprintf("type = '%s'", quote(*p.Type)))
+	}
+	if p.AccountID != nil {
+		conditions = append(conditions, fmt.Sprintf("accountId = '%d'", *p.AccountID))
+	}
+	for _, tag := range p.Tags {
+		conditions = append(conditions, fmt.Sprintf("tags.`%s` = '%s'", tag.Key, quote(tag.Value)))
+	}
+	if p.Query != nil {
+		conditions = append(conditions, "("+*p.Query+")")
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// MatchingGUIDs returns the GUIDs of the search results. The search matches names on a
+// substring, so only exact name matches are kept.
+func MatchingGUIDs(p v1alpha1.EntityLookupParameters, results []entities.EntityOutlineInterface) []string {
+	guids := []string{}
+	for _, entity := range results {
+		if entity == nil {
+			continue
+		}
+		if p.Name != nil && entity.GetName() != pointy.StringValue(p.Name, "") {
+			continue
+		}
+		guids = append(guids, string(entity.GetGUID()))
+	}
+	return guids
+}
+
+func quote(s string) string {
+	return strings.ReplaceAll(s, "'", "\\'")
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package entitylookup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/entitylookup/v1alpha1"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/fake"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+)
+
+func TestGenerateQuery(t *testing.T) {
+
+	cases := map[string]struct {
+		args v1alpha1.EntityLookupParameters
+		want string
+	}{
+		"NameAndType": {
+			args: v1alpha1.EntityLookupParameters{
+				Name:   pointy.String("checkout"),
+				Domain: pointy.String("APM"),
+				Type:   pointy.String("APPLICATION"),
+			},
+			want: "name = 'checkout' AND domain = 'APM' AND type = 'APPLICATION'",
+		},
+		"TagsAndQuery": {
+			args: v1alpha1.EntityLookupParameters{
+				AccountID: pointy.Int(1234567),
+				Tags:      []v1alpha1.EntityTag{{Key: "team", Value: "payments"}, {Key: "env", Value: "prod"}},
+				Query:     pointy.String("reporting = 'true'"),
+			},
+			want: "accountId = '1234567' AND tags.`team` = 'payments' AND tags.`env` = 'prod' AND (reporting = 'true')",
+		},
+		"QuotedName": {
+			args: v1alpha1.EntityLookupParameters{Name: pointy.String("bob's app")},
+			want: `name = 'bob\'s app'`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateQuery(tc.args)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestGenerateQuery(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMatchingGUIDs(t *testing.T) {
+	results := []entities.EntityOutlineInterface{
+		&entities.ApmApplicationEntityOutline{GUID: "MXxBUE18QVBQTElDQVRJT058MQ", Name: "checkout"},
+		&entities.ApmApplicationEntityOutline{GUID: "MXxBUE18QVBQTElDQVRJT058Mg", Name: "checkout-worker"},
+		nil,
+	}
+
+	cases := map[string]struct {
+		args v1alpha1.EntityLookupParameters
+		want []string
+	}{
+		"ExactName": {
+			args: v1alpha1.EntityLookupParameters{Name: pointy.String("checkout")},
+			want: []string{"MXxBUE18QVBQTElDQVRJT058MQ"},
+		},
+		"NoName": {
+			args: v1alpha1.EntityLookupParameters{Domain: pointy.String("APM")},
+			want: []string{"MXxBUE18QVBQTElDQVRJT058MQ", "MXxBUE18QVBQTElDQVRJT058Mg"},
+		},
+		"NoMatch": {
+			args: v1alpha1.EntityLookupParameters{Name: pointy.String("orders")},
+			want: []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := MatchingGUIDs(tc.args, results)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestMatchingGUIDs(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	search := func(results ...entities.EntityOutlineInterface) *fake.MockEntitiesClient {
+		return &fake.MockEntitiesClient{
+			MockGetEntitySearchByQueryWithContext: func(_ context.Context, _ entities.EntitySearchOptions, _ string, _ []entities.EntitySearchSortCriteria) (*entities.EntitySearch, error) {
+				return &entities.EntitySearch{Results: entities.EntitySearchResult{Entities: results}}, nil
+			},
+		}
+	}
+	lookup := func() *v1alpha1.EntityLookup {
+		return &v1alpha1.EntityLookup{Spec: v1alpha1.EntityLookupSpec{ForProvider: v1alpha1.EntityLookupParameters{Name: pointy.String("checkout")}}}
+	}
+
+	type args struct {
+		entities *fake.MockEntitiesClient
+		mg       resource.Managed
+	}
+
+	type want struct {
+		o      managed.ExternalObservation
+		status v1alpha1.EntityLookupObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotEntityLookup": {
+			args: args{entities: &fake.MockEntitiesClient{}},
+			want: want{err: errors.New(errNotEntityLookup)},
+		},
+		"Found": {
+			args: args{
+				entities: search(&entities.ApmApplicationEntityOutline{GUID: "MXxBUE18QVBQTElDQVRJT058MQ", Name: "checkout"}),
+				mg:       lookup(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"guid": []byte("MXxBUE18QVBQTElDQVRJT058MQ")},
+				},
+				status: v1alpha1.EntityLookupObservation{GUID: "MXxBUE18QVBQTElDQVRJT058MQ", GUIDs: []string{"MXxBUE18QVBQTElDQVRJT058MQ"}, Count: 1},
+			},
+		},
+		"NoMatch": {
+			args: args{
+				entities: search(&entities.ApmApplicationEntityOutline{GUID: "MXxBUE18QVBQTElDQVRJT058Mg", Name: "checkout-worker"}),
+				mg:       lookup(),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				status: v1alpha1.EntityLookupObservation{GUIDs: []string{}},
+			},
+		},
+		"SearchFailed": {
+			args: args{
+				entities: &fake.MockEntitiesClient{
+					MockGetEntitySearchByQueryWithContext: func(_ context.Context, _ entities.EntitySearchOptions, _ string, _ []entities.EntitySearchSortCriteria) (*entities.EntitySearch, error) {
+						return nil, errBoom
+					},
+				},
+				mg: lookup(),
+			},
+			want: want{err: nrerrors.Classify(errBoom)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{entities: tc.args.entities, kube: &test.MockClient{}, accountID: 1}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.EntityLookup); ok {
+				if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider); diff != "" {
+					t.Errorf("e.Observe(...): -want status, +got status:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/usermanagement"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/usermanagement/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotGroup     = "managed resource is not a Group custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+)
+
+// Setup adds a controller that reconciles Group.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.GroupGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.GroupKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.GroupGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Group{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return nil, errors.New(errNotGroup)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ExtractNewRelicAccountID(pc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client    *newrelic.NewRelic
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroup)
+	}
+
+	group, err := c.GetGroupByIDOrName(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if group == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update the status
+	cr.Status.AtProvider = v1alpha1.GroupObservation{
+		ID:        group.ID,
+		UserCount: len(group.Users.Users),
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  IsUpToDate(cr, *group),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroup)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	input := usermanagement.UserManagementCreateGroup{
+		AuthenticationDomainId: cr.Spec.ForProvider.AuthenticationDomainID,
+		DisplayName:            cr.Spec.ForProvider.DisplayName,
+	}
+	response, err := c.client.UserManagement.UserManagementCreateGroupWithContext(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
+	}
+
+	// The group ID is the identity of the group
+	meta.SetExternalName(cr, response.Group.ID)
+	cr.Status.AtProvider.ID = response.Group.ID
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroup)
+	}
+
+	input := usermanagement.UserManagementUpdateGroup{
+		DisplayName: cr.Spec.ForProvider.DisplayName,
+		ID:          meta.GetExternalName(cr),
+	}
+	_, err := c.client.UserManagement.UserManagementUpdateGroupWithContext(ctx, input)
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nrerrors.Classify(err)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return errors.New(errNotGroup)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return nil
+	}
+
+	_, err := c.client.UserManagement.UserManagementDeleteGroupWithContext(ctx, usermanagement.UserManagementDeleteGroup{ID: id})
+	return nrerrors.IgnoreNotFound(err)
+}
+
+// GetGroupByIDOrName returns the group identified by the external name. Without an external
+// name it falls back to a group of the authentication domain with the same display name, so
+// existing groups can be adopted. Returns nil if no group was found.
+func (c *external) GetGroupByIDOrName(ctx context.Context, cr *v1alpha1.Group) (*usermanagement.UserManagementGroup, error) {
+	domains := []string{cr.Spec.ForProvider.AuthenticationDomainID}
+	id := meta.GetExternalName(cr)
+
+	var result *usermanagement.UserManagementAuthenticationDomains
+	var err error
+	if id != "" {
+		result, err = c.client.UserManagement.UserManagementGetGroupsWithUsersWithContext(ctx, domains, []string{id}, "")
+	} else {
+		result, err = c.client.UserManagement.UserManagementGetGroupsWithUsersWithContext(ctx, domains, nil, cr.Spec.ForProvider.DisplayName)
+	}
+	if err != nil {
+		return nil, nrerrors.Classify(err)
+	}
+
+	for _, domain := range result.AuthenticationDomains {
+		for _, group := range domain.Groups.Groups {
+			if id != "" && group.ID == id {
+				return &group, nil
+			}
+			if id == "" && group.DisplayName == cr.Spec.ForProvider.DisplayName {
+				meta.SetExternalName(cr, group.ID)
+				_ = c.kube.Update(ctx, cr)
+				return &group, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// IsUpToDate determines whether the Group needs to be updated
+func IsUpToDate(p *v1alpha1.Group, group usermanagement.UserManagementGroup) bool {
+	return p.Spec.ForProvider.DisplayName == group.DisplayName
+}
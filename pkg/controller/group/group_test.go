@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/usermanagement"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/usermanagement/v1alpha1"
+)
+
+type groupModifier func(*v1alpha1.Group)
+
+func group(m ...groupModifier) *v1alpha1.Group {
+	cr := &v1alpha1.Group{
+		Spec: v1alpha1.GroupSpec{
+			ForProvider: v1alpha1.GroupParameters{
+				AuthenticationDomainID: "10a1b2c3-0000-4000-8000-000000000001",
+				DisplayName:            "Platform team",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.Group
+		nr usermanagement.UserManagementGroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"DiffDisplayName": {
+			args: args{cr: *group(func(cr *v1alpha1.Group) {
+				cr.Spec.ForProvider.DisplayName = "SRE team"
+			}),
+				nr: usermanagement.UserManagementGroup{ID: "20b1c2d3", DisplayName: "Platform team"},
+			},
+			want: false,
+		},
+		"Same": {
+			args: args{cr: *group(),
+				nr: usermanagement.UserManagementGroup{ID: "20b1c2d3", DisplayName: "Platform team"},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(&tc.args.cr, tc.args.nr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupmembership
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/usermanagement"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/usermanagement/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotGroupMembership = "managed resource is not a GroupMembership custom resource"
+	errTrackPCUsage       = "cannot track ProviderConfig usage"
+	errGetPC              = "cannot get ProviderConfig"
+	errExternalName       = "external name must be of the form <groupId>:<userId>"
+)
+
+// Setup adds a controller that reconciles GroupMembership.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.GroupMembershipGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.GroupMembershipKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.GroupMembershipGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.GroupMembership{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return nil, errors.New(errNotGroupMembership)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ExtractNewRelicAccountID(pc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client    *newrelic.NewRelic
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroupMembership)
+	}
+
+	// Without an external name an existing membership is adopted
+	groupID, userID := pointy.StringValue(cr.Spec.ForProvider.GroupID, ""), pointy.StringValue(cr.Spec.ForProvider.UserID, "")
+	if name := meta.GetExternalName(cr); name != "" {
+		var err error
+		if groupID, userID, err = ParseExternalName(name); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	result, err := c.client.UserManagement.UserManagementGetGroupsWithUsersWithContext(ctx, nil, []string{groupID}, "")
+	if err != nil {
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
+	}
+	group, user := FindMembership(result, groupID, userID)
+	if user == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		meta.SetExternalName(cr, ExternalName(groupID, userID))
+		_ = c.kube.Update(ctx, cr)
+	}
+
+	// Update the status
+	cr.Status.AtProvider = v1alpha1.GroupMembershipObservation{
+		GroupName: group.DisplayName,
+		UserEmail: user.Email,
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  IsUpToDate(cr),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroupMembership)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	groupID, userID := pointy.StringValue(cr.Spec.ForProvider.GroupID, ""), pointy.StringValue(cr.Spec.ForProvider.UserID, "")
+	if err := c.addUserToGroup(ctx, groupID, userID); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, ExternalName(groupID, userID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGroupMembership)
+	}
+
+	// The group or user changed, so the old membership is replaced
+	oldGroupID, oldUserID, err := ParseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	groupID, userID := pointy.StringValue(cr.Spec.ForProvider.GroupID, ""), pointy.StringValue(cr.Spec.ForProvider.UserID, "")
+	if err := c.addUserToGroup(ctx, groupID, userID); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	meta.SetExternalName(cr, ExternalName(groupID, userID))
+	if err := c.removeUserFromGroup(ctx, oldGroupID, oldUserID); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.GroupMembership)
+	if !ok {
+		return errors.New(errNotGroupMembership)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if meta.GetExternalName(cr) == "" {
+		return nil
+	}
+	groupID, userID, err := ParseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+
+	return c.removeUserFromGroup(ctx, groupID, userID)
+}
+
+func (c *external) addUserToGroup(ctx context.Context, groupID, userID string) error {
+	input := usermanagement.UserManagementUsersGroupsInput{GroupIds: []string{groupID}, UserIDs: []string{userID}}
+	_, err := c.client.UserManagement.UserManagementAddUsersToGroupsWithContext(ctx, input)
+	return nrerrors.Classify(err)
+}
+
+func (c *external) removeUserFromGroup(ctx context.Context, groupID, userID string) error {
+	input := usermanagement.UserManagementUsersGroupsInput{GroupIds: []string{groupID}, UserIDs: []string{userID}}
+	_, err := c.client.UserManagement.UserManagementRemoveUsersFromGroupsWithContext(ctx, input)
+	return nrerrors.Classify(err)
+}
+
+// ExternalName returns the external name of a membership of a user in a group
+func ExternalName(groupID, userID string) string {
+	return fmt.Sprintf("%s:%s", groupID, userID)
+}
+
+// ParseExternalName returns the group and user ID of a membership external name
+func ParseExternalName(name string) (string, string, error) {
+	parts := strings.Split(name, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New(errExternalName)
+	}
+	return parts[0], parts[1], nil
+}
+
+// FindMembership returns the group and the user if the user is a member of the group
+func FindMembership(result *usermanagement.UserManagementAuthenticationDomains, groupID, userID string) (*usermanagement.UserManagementGroup, *usermanagement.UserManagementGroupUser) {
+	for _, domain := range result.AuthenticationDomains {
+		for _, group := range domain.Groups.Groups {
+			if group.ID != groupID {
+				continue
+			}
+			for _, user := range group.Users.Users {
+				if user.ID == userID {
+					return &group, &user
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// IsUpToDate determines whether the membership still matches the desired group and user
+func IsUpToDate(p *v1alpha1.GroupMembership) bool {
+	return meta.GetExternalName(p) == ExternalName(pointy.StringValue(p.Spec.ForProvider.GroupID, ""), pointy.StringValue(p.Spec.ForProvider.UserID, ""))
+}
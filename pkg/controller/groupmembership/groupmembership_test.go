@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupmembership
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/usermanagement/v1alpha1"
+)
+
+type membershipModifier func(*v1alpha1.GroupMembership)
+
+func membership(m ...membershipModifier) *v1alpha1.GroupMembership {
+	cr := &v1alpha1.GroupMembership{
+		Spec: v1alpha1.GroupMembershipSpec{
+			ForProvider: v1alpha1.GroupMembershipParameters{
+				GroupID: pointy.String("20b1c2d3"),
+				UserID:  pointy.String("1000123456"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, "20b1c2d3:1000123456")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.GroupMembership
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"DiffGroup": {
+			args: args{cr: *membership(func(cr *v1alpha1.GroupMembership) {
+				cr.Spec.ForProvider.GroupID = pointy.String("30c1d2e3")
+			})},
+			want: false,
+		},
+		"DiffUser": {
+			args: args{cr: *membership(func(cr *v1alpha1.GroupMembership) {
+				cr.Spec.ForProvider.UserID = pointy.String("1000654321")
+			})},
+			want: false,
+		},
+		"Same": {
+			args: args{cr: *membership()},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(&tc.args.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestParseExternalName(t *testing.T) {
+
+	type want struct {
+		groupID string
+		userID  string
+		err     bool
+	}
+
+	cases := map[string]struct {
+		name string
+		want want
+	}{
+		"Valid":   {name: "20b1c2d3:1000123456", want: want{groupID: "20b1c2d3", userID: "1000123456"}},
+		"NoUser":  {name: "20b1c2d3:", want: want{err: true}},
+		"NoColon": {name: "20b1c2d3", want: want{err: true}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			groupID, userID, err := ParseExternalName(tc.name)
+			got := want{groupID: groupID, userID: userID, err: err != nil}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("e.TestParseExternalName(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keytransaction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/keytransaction/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotKeyTransaction = "managed resource is not a KeyTransaction custom resource"
+	errTrackPCUsage      = "cannot track ProviderConfig usage"
+	errGetPC             = "cannot get ProviderConfig"
+	errNoApplication     = "either applicationGuid or applicationName must be set"
+	errAppNotFound       = "cannot find APM application named %q"
+)
+
+// Setup adds a controller that reconciles KeyTransaction.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.KeyTransactionGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.KeyTransactionKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.KeyTransactionGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.KeyTransaction{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.KeyTransaction)
+	if !ok {
+		return nil, errors.New(errNotKeyTransaction)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client    *newrelic.NewRelic
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.KeyTransaction)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKeyTransaction)
+	}
+
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	keyTransaction, err := GetKeyTransaction(ctx, c.client, guid)
+	if err != nil {
+		if nrerrors.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
+	}
+
+	// The entity query returns an empty object when the GUID is unknown
+	if keyTransaction == nil || keyTransaction.GUID == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update the status
+	cr.Status.AtProvider = v1alpha1.KeyTransactionObservation{
+		GUID:            string(keyTransaction.GUID),
+		ApplicationGUID: string(keyTransaction.Application.GUID),
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  IsUpToDate(cr, *keyTransaction),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.KeyTransaction)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKeyTransaction)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	applicationGUID, err := c.GetApplicationGUID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	input := KeyTransactionCreateInput{
+		ApdexTarget:        cr.Spec.ForProvider.ApdexTarget,
+		ApplicationGUID:    common.EntityGUID(applicationGUID),
+		BrowserApdexTarget: cr.Spec.ForProvider.BrowserApdexTarget,
+		MetricName:         cr.Spec.ForProvider.MetricName,
+		Name:               cr.Spec.ForProvider.Name,
+	}
+	response, err := CreateKeyTransaction(ctx, c.client, input)
+	if err != nil {
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
+	}
+
+	// The GUID is the identity of the key transaction
+	meta.SetExternalName(cr, string(response.GUID))
+	cr.Status.AtProvider = v1alpha1.KeyTransactionObservation{
+		GUID:            string(response.GUID),
+		ApplicationGUID: applicationGUID,
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.KeyTransaction)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKeyTransaction)
+	}
+
+	input := KeyTransactionUpdateInput{
+		ApdexTarget:        cr.Spec.ForProvider.ApdexTarget,
+		BrowserApdexTarget: cr.Spec.ForProvider.BrowserApdexTarget,
+		GUID:               common.EntityGUID(meta.GetExternalName(cr)),
+		Name:               cr.Spec.ForProvider.Name,
+	}
+	_, err := UpdateKeyTransaction(ctx, c.client, input)
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nrerrors.Classify(err)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.KeyTransaction)
+	if !ok {
+		return errors.New(errNotKeyTransaction)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	guid := meta.GetExternalName(cr)
+	if guid == "" {
+		return nil
+	}
+
+	return nrerrors.IgnoreNotFound(DeleteKeyTransaction(ctx, c.client, common.EntityGUID(guid)))
+}
+
+// GetApplicationGUID returns the GUID of the application the key transaction belongs to,
+// looking the application up by name in the account if no GUID was given
+func (c *external) GetApplicationGUID(ctx context.Context, cr *v1alpha1.KeyTransaction) (string, error) {
+	if cr.Spec.ForProvider.ApplicationGUID != "" {
+		return cr.Spec.ForProvider.ApplicationGUID, nil
+	}
+	name := pointy.StringValue(cr.Spec.ForProvider.ApplicationName, "")
+	if name == "" {
+		return "", errors.New(errNoApplication)
+	}
+
+	query := fmt.Sprintf("domain = 'APM' AND type = 'APPLICATION' AND accountId = %d AND name = '%s'", c.accountID, strings.ReplaceAll(name, "'", "\\'"))
+	search, err := c.client.Entities.GetEntitySearchByQueryWithContext(ctx, entities.EntitySearchOptions{}, query, []entities.EntitySearchSortCriteria{})
+	if err != nil {
+		return "", nrerrors.Classify(err)
+	}
+	// The search matches on a substring, so only accept an exact match
+	for _, entity := range search.Results.Entities {
+		if entity != nil && entity.GetName() == name {
+			return string(entity.GetGUID()), nil
+		}
+	}
+	return "", errors.Errorf(errAppNotFound, name)
+}
+
+// IsUpToDate determines whether the KeyTransaction needs to be updated. The
+// application and metric name are immutable, so they are not compared.
+func IsUpToDate(p *v1alpha1.KeyTransaction, kt KeyTransactionEntity) bool {
+	if !cmp.Equal(p.Spec.ForProvider.Name, kt.Name, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(p.Spec.ForProvider.ApdexTarget, kt.ApdexTarget) {
+		return false
+	}
+	return cmp.Equal(p.Spec.ForProvider.BrowserApdexTarget, kt.BrowserApdexTarget)
+}
+
+// The key transaction mutations are not part of newrelic-client-go yet, so they are
+// sent as raw NerdGraph requests.
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-key-transactions/
+
+// KeyTransactionCreateInput are the arguments of the keyTransactionCreate mutation
+type KeyTransactionCreateInput struct {
+	ApdexTarget        float64
+	ApplicationGUID    common.EntityGUID
+	BrowserApdexTarget float64
+	MetricName         string
+	Name               string
+}
+
+// KeyTransactionUpdateInput are the arguments of the keyTransactionUpdate mutation
+type KeyTransactionUpdateInput struct {
+	ApdexTarget        float64
+	BrowserApdexTarget float64
+	GUID               common.EntityGUID
+	Name               string
+}
+
+// KeyTransactionApplication is the application a key transaction belongs to
+type KeyTransactionApplication struct {
+	GUID common.EntityGUID `json:"guid"`
+}
+
+// KeyTransactionEntity is a key transaction as returned by NerdGraph
+type KeyTransactionEntity struct {
+	ApdexTarget        float64                   `json:"apdexTarget"`
+	Application        KeyTransactionApplication `json:"application"`
+	BrowserApdexTarget float64                   `json:"browserApdexTarget"`
+	GUID               common.EntityGUID         `json:"guid"`
+	MetricName         string                    `json:"metricName"`
+	Name               string                    `json:"name"`
+}
+
+const getKeyTransactionQuery = `query($guid: EntityGuid!) {
+	actor {
+		entity(guid: $guid) {
+			guid
+			name
+			... on KeyTransactionEntity {
+				apdexTarget
+				browserApdexTarget
+				metricName
+				application {
+					guid
+				}
+			}
+		}
+	}
+}`
+
+const keyTransactionCreateMutation = `mutation(
+	$apdexTarget: Float!,
+	$applicationGuid: EntityGuid!,
+	$browserApdexTarget: Float!,
+	$metricName: String!,
+	$name: String!,
+) { keyTransactionCreate(
+	apdexTarget: $apdexTarget,
+	applicationGuid: $applicationGuid,
+	browserApdexTarget: $browserApdexTarget,
+	metricName: $metricName,
+	name: $name,
+) {
+	apdexTarget
+	application {
+		guid
+	}
+	browserApdexTarget
+	guid
+	metricName
+	name
+} }`
+
+const keyTransactionUpdateMutation = `mutation(
+	$apdexTarget: Float,
+	$browserApdexTarget: Float,
+	$guid: EntityGuid!,
+	$name: String,
+) { keyTransactionUpdate(
+	apdexTarget: $apdexTarget,
+	browserApdexTarget: $browserApdexTarget,
+	guid: $guid,
+	name: $name,
+) {
+	apdexTarget
+	application {
+		guid
+	}
+	browserApdexTarget
+	name
+} }`
+
+const keyTransactionDeleteMutation = `mutation($guid: EntityGuid!) {
+	keyTransactionDelete(guid: $guid) {
+		success
+	}
+}`
+
+// GetKeyTransaction fetches a key transaction entity by GUID
+func GetKeyTransaction(ctx context.Context, client *newrelic.NewRelic, guid string) (*KeyTransactionEntity, error) {
+	resp := struct {
+		Actor struct {
+			Entity *KeyTransactionEntity `json:"entity"`
+		} `json:"actor"`
+	}{}
+	vars := map[string]interface{}{
+		"guid": guid,
+	}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, getKeyTransactionQuery, vars, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Actor.Entity, nil
+}
+
+// CreateKeyTransaction creates a key transaction
+func CreateKeyTransaction(ctx context.Context, client *newrelic.NewRelic, input KeyTransactionCreateInput) (*KeyTransactionEntity, error) {
+	resp := struct {
+		KeyTransactionCreate KeyTransactionEntity `json:"keyTransactionCreate"`
+	}{}
+	vars := map[string]interface{}{
+		"apdexTarget":        input.ApdexTarget,
+		"applicationGuid":    input.ApplicationGUID,
+		"browserApdexTarget": input.BrowserApdexTarget,
+		"metricName":         input.MetricName,
+		"name":               input.Name,
+	}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, keyTransactionCreateMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.KeyTransactionCreate, nil
+}
+
+// UpdateKeyTransaction updates the name and Apdex targets of a key transaction
+func UpdateKeyTransaction(ctx context.Context, client *newrelic.NewRelic, input KeyTransactionUpdateInput) (*KeyTransactionEntity, error) {
+	resp := struct {
+		KeyTransactionUpdate KeyTransactionEntity `json:"keyTransactionUpdate"`
+	}{}
+	vars := map[string]interface{}{
+		"apdexTarget":        input.ApdexTarget,
+		"browserApdexTarget": input.BrowserApdexTarget,
+		"guid":               input.GUID,
+		"name":               input.Name,
+	}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, keyTransactionUpdateMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.KeyTransactionUpdate, nil
+}
+
+// DeleteKeyTransaction deletes a key transaction
+func DeleteKeyTransaction(ctx context.Context, client *newrelic.NewRelic, guid common.EntityGUID) error {
+	resp := struct {
+		KeyTransactionDelete struct {
+			Success bool `json:"success"`
+		} `json:"keyTransactionDelete"`
+	}{}
+	vars := map[string]interface{}{
+		"guid": guid,
+	}
+	return client.NerdGraph.QueryWithResponseAndContext(ctx, keyTransactionDeleteMutation, vars, &resp)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keytransaction
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/keytransaction/v1alpha1"
+)
+
+type keyTransactionModifier func(*v1alpha1.KeyTransaction)
+
+func keyTransaction(m ...keyTransactionModifier) *v1alpha1.KeyTransaction {
+	cr := &v1alpha1.KeyTransaction{
+		Spec: v1alpha1.KeyTransactionSpec{
+			ForProvider: v1alpha1.KeyTransactionParameters{
+				Name:               "checkout",
+				ApplicationGUID:    "MXxBUE18QVBQTElDQVRJT058MQ",
+				MetricName:         "WebTransaction/Controller/orders/create",
+				ApdexTarget:        0.5,
+				BrowserApdexTarget: 7,
+			},
+		},
+	}
+	meta.SetExternalName(cr, "MXxBUE18S0VZX1RSQU5TQUNUSU9OfDE")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.KeyTransaction
+		nr KeyTransactionEntity
+	}
+
+	type want struct {
+		expected bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DiffName": {
+			args: args{cr: *keyTransaction(),
+				nr: KeyTransactionEntity{
+					Name:               "checkout_renamed",
+					ApdexTarget:        0.5,
+					BrowserApdexTarget: 7,
+				},
+			},
+			want: want{expected: false},
+		},
+		"DiffApdexTarget": {
+			args: args{cr: *keyTransaction(),
+				nr: KeyTransactionEntity{
+					Name:               "checkout",
+					ApdexTarget:        1,
+					BrowserApdexTarget: 7,
+				},
+			},
+			want: want{expected: false},
+		},
+		"DiffBrowserApdexTarget": {
+			args: args{cr: *keyTransaction(),
+				nr: KeyTransactionEntity{
+					Name:               "checkout",
+					ApdexTarget:        0.5,
+					BrowserApdexTarget: 3,
+				},
+			},
+			want: want{expected: false},
+		},
+		"IgnoresMetricName": {
+			args: args{cr: *keyTransaction(),
+				nr: KeyTransactionEntity{
+					Name:               "checkout",
+					ApdexTarget:        0.5,
+					BrowserApdexTarget: 7,
+					MetricName:         "WebTransaction/Controller/orders/other",
+				},
+			},
+			want: want{expected: true},
+		},
+		"Same": {
+			args: args{cr: *keyTransaction(),
+				nr: KeyTransactionEntity{
+					Name:               "checkout",
+					ApdexTarget:        0.5,
+					BrowserApdexTarget: 7,
+					MetricName:         "WebTransaction/Controller/orders/create",
+				},
+			},
+			want: want{expected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(&tc.args.cr, tc.args.nr)
+			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookuptable
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/lookuptable/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotLookupTable = "managed resource is not a LookupTable custom resource"
+	errTrackPCUsage   = "cannot track ProviderConfig usage"
+	errGetPC          = "cannot get ProviderConfig"
+	errGetConfigMap   = "cannot get ConfigMap"
+	errNoKey          = "ConfigMap %s/%s has no key %q"
+	errParseCSV       = "cannot parse lookup table CSV"
+	errLookupAPI      = "lookup table API returned %s: %s"
+
+	lookupBaseURL   = "https://nrql-lookups.service.newrelic.com/v1/accounts"
+	lookupBaseURLEU = "https://nrql-lookups.service.eu.newrelic.com/v1/accounts"
+)
+
+// Setup adds a controller that reconciles LookupTable.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.LookupTableGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.LookupTableKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.LookupTableGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.LookupTable{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LookupTable)
+	if !ok {
+		return nil, errors.New(errNotLookupTable)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lookup tables are managed through a REST API the client doesn't cover,
+	// so the API key is used directly
+	apiKey, err := nr.ExtractNewRelicAPIKey(ctx, c.kube, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := lookupBaseURL
+	if pc.Spec.Region != nil && strings.EqualFold(*pc.Spec.Region, "EU") {
+		baseURL = lookupBaseURLEU
+	}
+
+	return &external{
+		kube: c.kube,
+		lookup: &LookupTableClient{
+			HTTP:      http.DefaultClient,
+			BaseURL:   baseURL,
+			APIKey:    apiKey,
+			AccountID: accountID,
+		},
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube   client.Client
+	lookup *LookupTableClient
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LookupTable)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLookupTable)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	exists, err := c.lookup.Exists(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
+	}
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	content, err := c.GetContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  IsUpToDate(cr, content),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LookupTable)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLookupTable)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	content, err := c.GetContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	rows, err := CountRows(content)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if err := c.lookup.Upload(ctx, http.MethodPost, cr.Spec.ForProvider.TableName, content); err != nil {
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
+	}
+
+	// The table name is the identity of the table
+	meta.SetExternalName(cr, cr.Spec.ForProvider.TableName)
+	cr.Status.AtProvider = GenerateObservation(content, rows)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LookupTable)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLookupTable)
+	}
+
+	content, err := c.GetContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	rows, err := CountRows(content)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Uploading with PUT replaces the whole table
+	if err := c.lookup.Upload(ctx, http.MethodPut, meta.GetExternalName(cr), content); err != nil {
+		return managed.ExternalUpdate{}, nrerrors.Classify(err)
+	}
+	cr.Status.AtProvider = GenerateObservation(content, rows)
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LookupTable)
+	if !ok {
+		return errors.New(errNotLookupTable)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if meta.GetExternalName(cr) == "" {
+		return nil
+	}
+
+	return c.lookup.Delete(ctx, meta.GetExternalName(cr))
+}
+
+// GetContent reads the CSV from the ConfigMap
+func (c *external) GetContent(ctx context.Context, cr *v1alpha1.LookupTable) ([]byte, error) {
+	ref := cr.Spec.ForProvider.ConfigMapRef
+	cm := &corev1.ConfigMap{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return nil, errors.Wrap(err, errGetConfigMap)
+	}
+	if data, ok := cm.Data[ref.Key]; ok {
+		return []byte(data), nil
+	}
+	if data, ok := cm.BinaryData[ref.Key]; ok {
+		return data, nil
+	}
+	return nil, errors.Errorf(errNoKey, ref.Namespace, ref.Name, ref.Key)
+}
+
+// ContentHash returns the hash stored in status for some CSV content
+func ContentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// CountRows returns the number of rows of a CSV, excluding the header
+func CountRows(content []byte) (int, error) {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return 0, errors.Wrap(err, errParseCSV)
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+	return len(records) - 1, nil
+}
+
+// GenerateObservation produces the status after uploading some CSV content
+func GenerateObservation(content []byte, rows int) v1alpha1.LookupTableObservation {
+	now := metav1.Now()
+	return v1alpha1.LookupTableObservation{
+		RowCount:       rows,
+		ContentHash:    ContentHash(content),
+		LastUploadTime: &now,
+	}
+}
+
+// IsUpToDate determines whether the LookupTable needs to be uploaded again. The table
+// contents can't be compared cheaply, so the hash of the last upload is used instead.
+func IsUpToDate(p *v1alpha1.LookupTable, content []byte) bool {
+	return p.Status.AtProvider.ContentHash == ContentHash(content)
+}
+
+// LookupTableClient calls the lookup table REST API, which is not part of newrelic-client-go.
+// https://docs.newrelic.com/docs/logs/ui-data/lookup-tables-ui/#upload-via-api
+type LookupTableClient struct {
+	HTTP      *http.Client
+	BaseURL   string
+	APIKey    string
+	AccountID int
+}
+
+func (l *LookupTableClient) url(table string) string {
+	return fmt.Sprintf("%s/%d/%s", l.BaseURL, l.AccountID, url.PathEscape(table))
+}
+
+func (l *LookupTableClient) do(ctx context.Context, method, table string, body io.Reader, contentType string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, l.url(table), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Api-Key", l.APIKey)
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return l.HTTP.Do(req)
+}
+
+func apiError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return &nrerrors.Error{
+		Reason: nrerrors.ReasonForStatusCode(resp.StatusCode),
+		Err:    errors.Errorf(errLookupAPI, resp.Status, strings.TrimSpace(string(body))),
+	}
+}
+
+// Exists reports whether a table exists
+func (l *LookupTableClient) Exists(ctx context.Context, table string) (bool, error) {
+	resp, err := l.do(ctx, http.MethodGet, table, nil, "")
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case resp.StatusCode >= 300:
+		return false, apiError(resp)
+	}
+	return true, nil
+}
+
+// Upload creates a table with POST or replaces it with PUT
+func (l *LookupTableClient) Upload(ctx context.Context, method, table string, content []byte) error {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	part, err := w.CreateFormFile("file", table+".csv")
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(content); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	resp, err := l.do(ctx, method, table, body, w.FormDataContentType())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode >= 300 {
+		return apiError(resp)
+	}
+	return nil
+}
+
+// Delete deletes a table
+func (l *LookupTableClient) Delete(ctx context.Context, table string) error {
+	resp, err := l.do(ctx, http.MethodDelete, table, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode >= 300 && resp.StatusCode != http.StatusNotFound {
+		return apiError(resp)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookuptable
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/lookuptable/v1alpha1"
+)
+
+const teams = `service,team,tier
+checkout,payments,1
+search,discovery,2
+`
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr      v1alpha1.LookupTable
+		content string
+	}
+
+	type want struct {
+		expected bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NeverUploaded": {
+			args: args{cr: v1alpha1.LookupTable{}, content: teams},
+			want: want{expected: false},
+		},
+		"DiffContent": {
+			args: args{cr: v1alpha1.LookupTable{
+				Status: v1alpha1.LookupTableStatus{AtProvider: v1alpha1.LookupTableObservation{ContentHash: ContentHash([]byte(teams))}},
+			}, content: teams + "ingest,platform,1\n"},
+			want: want{expected: false},
+		},
+		"Same": {
+			args: args{cr: v1alpha1.LookupTable{
+				Status: v1alpha1.LookupTableStatus{AtProvider: v1alpha1.LookupTableObservation{ContentHash: ContentHash([]byte(teams))}},
+			}, content: teams},
+			want: want{expected: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(&tc.args.cr, []byte(tc.args.content))
+			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCountRows(t *testing.T) {
+	cases := map[string]struct {
+		content string
+		rows    int
+		err     bool
+	}{
+		"Empty":      {content: "", rows: 0},
+		"HeaderOnly": {content: "service,team,tier\n", rows: 0},
+		"Rows":       {content: teams, rows: 2},
+		"Quoted":     {content: "service,team\n\"checkout, web\",payments\n", rows: 1},
+		"Invalid":    {content: "service,team\ncheckout\n", err: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := CountRows([]byte(tc.content))
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("CountRows(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.rows, got); diff != "" {
+				t.Errorf("CountRows(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -22,18 +22,17 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
-	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
-	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
 	"github.com/pkg/errors"
 	"go.openly.dev/pointy"
@@ -44,6 +43,7 @@ import (
 	"github.com/crossplane-contrib/provider-newrelic/apis/nrqlalertcondition/v1alpha1"
 	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
 	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
 	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
 )
 
@@ -54,26 +54,43 @@ const (
 	errGetAccountID = "cannot get accountID from ProviderConfig"
 )
 
+// policyNotFound matches the error of a condition created in a policy that
+// does not exist
+var policyNotFound = regexp.MustCompile(`Policy with ID \d* not found`)
+
+// Reasons of the events recorded on managed resources
+const (
+	reasonIDChanged     event.Reason = "IDChanged"
+	reasonAdoptedByName event.Reason = "AdoptedByName"
+	reasonSkippedDelete event.Reason = "SkippedDelete"
+	reasonDrifted       event.Reason = "Drifted"
+)
+
 // Setup adds a controller that reconciles NrqlAlertCondition.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.NrqlAlertConditionGroupKind)
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
 	}
 
+	log := o.Logger.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
-			kube:  mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
-		}),
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.NrqlAlertConditionKind, &connector{
+			kube:   mgr.GetClient(),
+			usage:  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			log:    log,
+			record: recorder,
+		})),
 		managed.WithConnectionPublishers(),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(log),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -96,8 +113,10 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 // A connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type connector struct {
-	kube  client.Client
-	usage resource.Tracker
+	kube   client.Client
+	usage  resource.Tracker
+	log    logging.Logger
+	record event.Recorder
 }
 
 // Connect typically produces an ExternalClient by:
@@ -121,26 +140,28 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	// Get the account id from the provider config
-	accountID, err := nr.ExtractNewRelicAccountID(pc)
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create a client using NR Credentials
-	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc)
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+	return &external{alerts: &nrClient.Alerts, kube: c.kube, accountID: accountID, log: c.log.WithValues("name", cr.GetName()), record: c.record}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	client    *newrelic.NewRelic
+	alerts    nr.AlertsClient
 	kube      client.Client
 	accountID int
+	log       logging.Logger
+	record    event.Recorder
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -152,31 +173,42 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	condition, err := c.GetNrqlConditionByIDOrName(ctx, cr)
 	// nerdgraph errors aren't great
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") {
+		if nrerrors.IsNotFound(err) {
 			return managed.ExternalObservation{
 				ResourceExists: false,
 			}, nil
 		}
-		return managed.ExternalObservation{}, err
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
 	}
 	// Nerdgraph may return an empty object
 	if condition == nil || condition.ID == "" {
-		return managed.ExternalObservation{ResourceExists: false}, err
+		return managed.ExternalObservation{ResourceExists: false}, nrerrors.Classify(err)
 	}
 	// Set the ID, if not set
 	c.SetExternalNameIfNotSet(ctx, cr, condition)
 
+	upToDate, drift := IsUpToDate(cr, condition)
+	if !upToDate {
+		c.log.Debug("Condition drifted from its spec", "fields", drift.Fields, "diff", drift.Diff)
+		// The drift is only recorded once, not on every poll until it is corrected
+		if drift.Summary() != cr.Status.AtProvider.DriftedFields {
+			c.record.Event(cr, event.Normal(reasonDrifted, "Condition differs from its spec in "+drift.Summary()))
+		}
+	}
+
 	// Update the status
 	cr.Status.SetConditions(xpv1.Available())
 	cr.Status.AtProvider = v1alpha1.NrqlAlertConditionObservation{
-		ID: condition.ID,
+		ID:            condition.ID,
+		DriftedFields: drift.Summary(),
 	}
 
 	// Resource was found
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  IsUpToDate(cr, condition),
-		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceUpToDate:  upToDate,
+		Diff:              drift.Diff,
+		ConnectionDetails: GenerateConnectionDetails(condition),
 	}, nil
 }
 
@@ -196,23 +228,31 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	// Create the condition
 	input := GenerateAlertConditionInput(cr)
-	response, err := CreateNrqlCondition(ctx, c.client, c.accountID, cr.Spec.ForProvider.AlertsPolicyID, input)
+	response, err := CreateNrqlCondition(ctx, c.alerts, c.accountID, cr.Spec.ForProvider.AlertsPolicyID, input)
 
 	if err != nil {
-		// If the policy is not found, re-run the referencer
-		matched, _ := regexp.MatchString(`Policy with ID \d* not found`, err.Error())
-		if matched {
+		// If the policy is not found, re-run the referencer. The alerts API only
+		// reports it in the message.
+		if policyNotFound.MatchString(err.Error()) {
 			cr.Spec.ForProvider.AlertsPolicyID = ""
 			_ = c.kube.Update(ctx, cr)
 		}
-		return managed.ExternalCreation{}, err
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
 	}
 
 	// Set the ID, if not set
 	meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
 	c.SetExternalNameIfNotSet(ctx, cr, response)
 	cr.Status.SetConditions(xpv1.Available())
-	return managed.ExternalCreation{}, nil
+	return managed.ExternalCreation{ConnectionDetails: GenerateConnectionDetails(response)}, nil
+}
+
+// GenerateConnectionDetails returns the connection details of a condition
+func GenerateConnectionDetails(condition *alerts.NrqlAlertCondition) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		"id":         []byte(condition.ID),
+		"entityGuid": []byte(condition.EntityGUID),
+	}
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -228,11 +268,11 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			ConnectionDetails: managed.ConnectionDetails{},
 		}, uErr
 	}
-	_, err := UpdateNrqlConditionStaticMutationWithContext(ctx, c.client, c.accountID, cr.Spec.ForProvider.ID, update)
+	_, err := UpdateNrqlConditionStaticMutationWithContext(ctx, c.alerts, c.accountID, cr.Spec.ForProvider.ID, update)
 
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
-	}, err
+	}, nrerrors.Classify(err)
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -243,20 +283,21 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 	if cr.Spec.ForProvider.ID == "" {
-		fmt.Printf("skipping delete for nrql condition %s: Id must be set", cr.Spec.ForProvider.Name)
+		c.log.Debug("Skipping delete of condition without an ID")
+		c.record.Event(cr, event.Normal(reasonSkippedDelete, "Skipped deleting the condition from New Relic because it has no ID"))
 		return nil
 	}
 
-	result, err := c.client.Alerts.DeleteNrqlConditionMutationWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID)
+	_, err := c.alerts.DeleteNrqlConditionMutationWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID)
+	if nrerrors.IsNotFound(err) {
+		c.log.Debug("Condition was already deleted", "id", cr.Spec.ForProvider.ID)
+		return nil
+	}
 	if err != nil {
-		fmt.Printf("Unable to delete condition %s: %s", cr.Spec.ForProvider.ID, err)
-		if strings.Contains(err.Error(), "Not Found") {
-			return nil
-		}
+		return nrerrors.Classify(err)
 	}
-	fmt.Printf("Deleted conditionID %s with result of %s", cr.Spec.ForProvider.ID, result)
-
-	return err
+	c.log.Debug("Deleted condition", "id", cr.Spec.ForProvider.ID)
+	return nil
 }
 
 func (c *external) SetExternalNameIfNotSet(ctx context.Context, cr *v1alpha1.NrqlAlertCondition, response *alerts.NrqlAlertCondition) {
@@ -274,25 +315,37 @@ func (c *external) GetNrqlConditionByIDOrName(ctx context.Context, cr *v1alpha1.
 	defaultCondition := &alerts.NrqlAlertCondition{}
 
 	// Get the condition by ID
-	conditionByID, err := c.client.Alerts.GetNrqlConditionQueryWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID)
+	conditionByID, err := c.alerts.GetNrqlConditionQueryWithContext(ctx, c.accountID, cr.Spec.ForProvider.ID)
 	if err == nil {
 		return conditionByID, nil
 	}
+	if !nrerrors.IsNotFound(err) {
+		return defaultCondition, nrerrors.Classify(err)
+	}
 
+	// If not found, the ID may have changed - attempt to look up the new ID
+	conditionByName, err := c.GetNrqlConditionByName(ctx, cr)
 	if err != nil {
-		// If not found, the ID may have changed - attempt to look up the new ID
-		if strings.Contains(err.Error(), "Not Found") {
-			conditionByName, err := c.GetNrqlConditionByName(ctx, cr)
-			if err == nil {
-				cr.Spec.ForProvider.ID = conditionByName.ID
-				_ = c.kube.Update(ctx, cr)
-				fmt.Println("Updating " + cr.Spec.ForProvider.Name + " with new ID: " + conditionByName.ID)
-				return conditionByName, nil
-			}
-			return defaultCondition, err
-		}
+		return defaultCondition, nrerrors.Classify(err)
+	}
+	if conditionByName.ID != "" {
+		c.recordNewID(cr, conditionByName.ID)
 	}
-	return defaultCondition, nil
+	cr.Spec.ForProvider.ID = conditionByName.ID
+	_ = c.kube.Update(ctx, cr)
+	return conditionByName, nil
+}
+
+// recordNewID records that a condition found by name was adopted, when the
+// managed resource had no ID yet, or that its ID changed
+func (c *external) recordNewID(cr *v1alpha1.NrqlAlertCondition, id string) {
+	if cr.Spec.ForProvider.ID == "" {
+		c.log.Info("Adopted existing condition by name", "id", id)
+		c.record.Event(cr, event.Normal(reasonAdoptedByName, fmt.Sprintf("Adopted existing condition %s by name", id)))
+		return
+	}
+	c.log.Info("Condition ID changed", "old-id", cr.Spec.ForProvider.ID, "id", id)
+	c.record.Event(cr, event.Normal(reasonIDChanged, fmt.Sprintf("Condition ID changed from %s to %s", cr.Spec.ForProvider.ID, id)))
 }
 
 // GetNrqlConditionByName uses the search function to get a condition by name
@@ -302,36 +355,36 @@ func (c *external) GetNrqlConditionByName(ctx context.Context, cr *v1alpha1.Nrql
 		PolicyID: cr.Spec.ForProvider.AlertsPolicyID,
 	}
 	// Search for conditions and always use the first one
-	conditions, err := c.client.Alerts.SearchNrqlConditionsQueryWithContext(ctx, c.accountID, criteria)
+	conditions, err := c.alerts.SearchNrqlConditionsQueryWithContext(ctx, c.accountID, criteria)
 	if len(conditions) > 0 && err == nil {
 		return conditions[0], nil
 	}
 	// Otherwise
-	return &alerts.NrqlAlertCondition{}, err
+	return &alerts.NrqlAlertCondition{}, nrerrors.Classify(err)
 }
 
 // CreateNrqlCondition calls the right API based on the condition type
-func CreateNrqlCondition(ctx context.Context, client *newrelic.NewRelic, accountID int, policyID string, input alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error) {
+func CreateNrqlCondition(ctx context.Context, client nr.AlertsClient, accountID int, policyID string, input alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error) {
 	conditionType := input.Type
 	input.Type = ""
 	// "Argument \"condition\" has invalid value $condition.\nIn field \"type\": Unknown field."}
 	if conditionType == "BASELINE" {
-		return client.Alerts.CreateNrqlConditionBaselineMutationWithContext(ctx, accountID, policyID, input)
+		return client.CreateNrqlConditionBaselineMutationWithContext(ctx, accountID, policyID, input)
 	}
 	// Default is static
-	return client.Alerts.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, policyID, input)
+	return client.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, policyID, input)
 }
 
 // UpdateNrqlConditionStaticMutationWithContext calls the right API based on the condition type
-func UpdateNrqlConditionStaticMutationWithContext(ctx context.Context, client *newrelic.NewRelic, accountID int, conditionID string, input alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error) {
+func UpdateNrqlConditionStaticMutationWithContext(ctx context.Context, client nr.AlertsClient, accountID int, conditionID string, input alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error) {
 	conditionType := input.Type
 	input.Type = ""
 	// "Argument \"condition\" has invalid value $condition.\nIn field \"type\": Unknown field."}
 	if conditionType == "BASELINE" {
-		return client.Alerts.UpdateNrqlConditionBaselineMutationWithContext(ctx, accountID, conditionID, input)
+		return client.UpdateNrqlConditionBaselineMutationWithContext(ctx, accountID, conditionID, input)
 	}
 	// Default is static
-	return client.Alerts.UpdateNrqlConditionStaticMutationWithContext(ctx, accountID, conditionID, input)
+	return client.UpdateNrqlConditionStaticMutationWithContext(ctx, accountID, conditionID, input)
 }
 
 // GenerateAlertConditionInput generates an input object
@@ -482,53 +535,50 @@ func GenerateNrqlConditionUpdateInput(input alerts.NrqlConditionCreateInput) (al
 	return update, nil
 }
 
-// IsUpToDate performs comparison
-func IsUpToDate(p *v1alpha1.NrqlAlertCondition, cd *alerts.NrqlAlertCondition) bool {
-
+// IsUpToDate determines whether the NrqlAlertCondition needs to be updated and
+// returns how the condition drifted from its spec
+func IsUpToDate(p *v1alpha1.NrqlAlertCondition, cd *alerts.NrqlAlertCondition) (bool, nr.Drift) {
 	input := GenerateAlertConditionInput(p)
 
-	if !cmp.Equal(input.Name, cd.Name, cmpopts.EquateEmpty()) {
-		return false
-	}
-	if !cmp.Equal(input.Type, cd.Type, cmpopts.EquateEmpty()) {
-		return false
-	}
-
-	if !cmp.Equal(input.RunbookURL, cd.RunbookURL, cmpopts.EquateEmpty()) {
-		return false
-	}
-
-	if !cmp.Equal(input.Enabled, cd.Enabled, cmpopts.EquateEmpty()) {
-		return false
-	}
-
-	if !cmp.Equal(input.ViolationTimeLimitSeconds, cd.ViolationTimeLimitSeconds, cmpopts.EquateEmpty()) {
-		return false
-	}
+	drift := nr.Drift{}
+	drift.Field("Name", input.Name, cd.Name, cmpopts.EquateEmpty())
+	drift.Field("Type", input.Type, cd.Type, cmpopts.EquateEmpty())
+	drift.Field("RunbookURL", input.RunbookURL, cd.RunbookURL, cmpopts.EquateEmpty())
+	drift.Field("Enabled", input.Enabled, cd.Enabled, cmpopts.EquateEmpty())
+	drift.Field("ViolationTimeLimitSeconds", input.ViolationTimeLimitSeconds, cd.ViolationTimeLimitSeconds, cmpopts.EquateEmpty())
 
 	// Compare whether the Terms are equal with custom function, ignore ordering
 	if !termsAreEqual(input.Terms, cd.Terms) {
-		return false
+		drift.Mismatch("Terms", input.Terms, cd.Terms)
 	}
 
-	if !cmp.Equal(input.Nrql.Query, cd.Nrql.Query, cmpopts.EquateEmpty()) {
-		return false
-	}
+	drift.Field("Nrql.Query", input.Nrql.Query, cd.Nrql.Query, cmpopts.EquateEmpty())
 
-	// Compare whether the Signals are equal with custom function
-	if !signalsAreEqual(*input.Signal, *cd.Signal) {
-		return false
+	// Compare whether the Signals are equal with custom function, a missing
+	// signal is the default one
+	signal, nrSignal := alerts.AlertsNrqlConditionCreateSignal{}, alerts.AlertsNrqlConditionSignal{}
+	if input.Signal != nil {
+		signal = *input.Signal
+	}
+	if cd.Signal != nil {
+		nrSignal = *cd.Signal
+	}
+	if !signalsAreEqual(signal, nrSignal) {
+		drift.Mismatch("Signal", input.Signal, cd.Signal)
 	}
 
 	if !expirationsAreEqual(input.Expiration, cd.Expiration) {
-		return false
+		drift.Mismatch("Expiration", input.Expiration, cd.Expiration)
 	}
-	return true
+	return drift.UpToDate(), drift
 }
 
 // expirationsAreEqual compares Expiration
 func expirationsAreEqual(expiration *alerts.AlertsNrqlConditionExpiration, nrExpiration *alerts.AlertsNrqlConditionExpiration) bool { //nolint:gocyclo
 
+	if expiration == nil && nrExpiration == nil {
+		return true
+	}
 	if (expiration == nil && nrExpiration != nil) || (expiration != nil && nrExpiration == nil) {
 		return false
 	}
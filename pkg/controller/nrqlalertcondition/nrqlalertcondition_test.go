@@ -17,14 +17,26 @@ limitations under the License.
 package nrqlalertcondition
 
 import (
+	"context"
 	"testing"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	nrerrs "github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/pkg/errors"
 	"go.openly.dev/pointy"
 
 	"github.com/crossplane-contrib/provider-newrelic/apis/nrqlalertcondition/v1alpha1"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/fake"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
 )
 
 type NrqlAlertConditionModifier func(*v1alpha1.NrqlAlertCondition)
@@ -81,6 +93,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	type want struct {
 		expected bool
+		fields   []string
 	}
 
 	cases := map[string]struct {
@@ -116,7 +129,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"Type"}},
 		},
 		"DiffRunbookUrl": {
 			args: args{cr: *NrqlAlertCondition(),
@@ -147,7 +160,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"RunbookURL"}},
 		},
 		"DiffEnabled": {
 			args: args{cr: *NrqlAlertCondition(),
@@ -177,7 +190,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"Enabled"}},
 		},
 		"DiffViolationTimeLimitSeconds": {
 			args: args{cr: *NrqlAlertCondition(),
@@ -207,7 +220,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"ViolationTimeLimitSeconds"}},
 		},
 		"DiffTerms": {
 			args: args{cr: *NrqlAlertCondition(),
@@ -237,7 +250,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"Terms"}},
 		},
 		"DiffNrqlQuery": {
 			args: args{cr: *NrqlAlertCondition(),
@@ -267,7 +280,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"Nrql.Query"}},
 		},
 		"DiffNrqlAggregationDelay": {
 			args: args{cr: *NrqlAlertCondition(),
@@ -297,7 +310,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"Signal"}},
 		},
 		"DiffNrqlAggregationMethod": {
 			args: args{cr: *NrqlAlertCondition(),
@@ -327,7 +340,7 @@ func TestIsUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want: want{expected: false},
+			want: want{expected: false, fields: []string{"Signal"}},
 		},
 		"TermsOutOfOrder-Same": {
 			args: args{cr: *NrqlAlertCondition(),
@@ -393,10 +406,13 @@ func TestIsUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := IsUpToDate(&tc.args.cr, &tc.args.nr)
+			got, drift := IsUpToDate(&tc.args.cr, &tc.args.nr)
 			if diff := cmp.Diff(tc.want.expected, got); diff != "" {
 				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
 			}
+			if diff := cmp.Diff(tc.want.fields, drift.Fields); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want fields, +got fields:\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -458,3 +474,401 @@ func TestGenerateNrqlConditionUpdateInput(t *testing.T) {
 		})
 	}
 }
+
+var errBoom = errors.New("boom")
+
+func nrCondition(id string) *alerts.NrqlAlertCondition {
+	return &alerts.NrqlAlertCondition{ID: id, NrqlConditionBase: alerts.NrqlConditionBase{Name: "test_nrql", EntityGUID: common.EntityGUID("GUID" + id)}}
+}
+
+func withType(t string) NrqlAlertConditionModifier {
+	return func(cr *v1alpha1.NrqlAlertCondition) { cr.Spec.ForProvider.Type = t }
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		alerts *fake.MockAlertsClient
+		mg     resource.Managed
+	}
+
+	type want struct {
+		o      managed.ExternalObservation
+		id     string
+		events []event.Reason
+		err    error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNrqlAlertCondition": {
+			args: args{alerts: &fake.MockAlertsClient{}},
+			want: want{err: errors.New(errNotChannel)},
+		},
+		"Exists": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockGetNrqlConditionQueryWithContext: func(_ context.Context, _ int, id string) (*alerts.NrqlAlertCondition, error) {
+						return nrCondition(id), nil
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ConnectionDetails: GenerateConnectionDetails(nrCondition("1"))},
+				id:     "1",
+				events: []event.Reason{reasonDrifted},
+			},
+		},
+		"DriftAlreadyRecorded": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockGetNrqlConditionQueryWithContext: func(_ context.Context, _ int, id string) (*alerts.NrqlAlertCondition, error) {
+						return nrCondition(id), nil
+					},
+				},
+				mg: NrqlAlertCondition(func(cr *v1alpha1.NrqlAlertCondition) {
+					cr.Status.AtProvider.DriftedFields = "Type, RunbookURL, ViolationTimeLimitSeconds, Terms, Nrql.Query and 2 more"
+				}),
+			},
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true, ConnectionDetails: GenerateConnectionDetails(nrCondition("1"))},
+				id: "1",
+			},
+		},
+		"DriftChanged": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockGetNrqlConditionQueryWithContext: func(_ context.Context, _ int, id string) (*alerts.NrqlAlertCondition, error) {
+						return nrCondition(id), nil
+					},
+				},
+				mg: NrqlAlertCondition(func(cr *v1alpha1.NrqlAlertCondition) { cr.Status.AtProvider.DriftedFields = "Type" }),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ConnectionDetails: GenerateConnectionDetails(nrCondition("1"))},
+				id:     "1",
+				events: []event.Reason{reasonDrifted},
+			},
+		},
+		"IDChanged": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockGetNrqlConditionQueryWithContext: func(_ context.Context, _ int, _ string) (*alerts.NrqlAlertCondition, error) {
+						return nil, nrerrs.NewNotFound("resource not found")
+					},
+					MockSearchNrqlConditionsQueryWithContext: func(_ context.Context, _ int, _ alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error) {
+						return []*alerts.NrqlAlertCondition{nrCondition("2")}, nil
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ConnectionDetails: GenerateConnectionDetails(nrCondition("2"))},
+				id:     "2",
+				events: []event.Reason{reasonIDChanged, reasonDrifted},
+			},
+		},
+		"AdoptedByName": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockGetNrqlConditionQueryWithContext: func(_ context.Context, _ int, _ string) (*alerts.NrqlAlertCondition, error) {
+						return nil, nrerrs.NewNotFound("resource not found")
+					},
+					MockSearchNrqlConditionsQueryWithContext: func(_ context.Context, _ int, _ alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error) {
+						return []*alerts.NrqlAlertCondition{nrCondition("2")}, nil
+					},
+				},
+				mg: NrqlAlertCondition(func(cr *v1alpha1.NrqlAlertCondition) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ConnectionDetails: GenerateConnectionDetails(nrCondition("2"))},
+				id:     "2",
+				events: []event.Reason{reasonAdoptedByName, reasonDrifted},
+			},
+		},
+		"NotFound": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockGetNrqlConditionQueryWithContext: func(_ context.Context, _ int, _ string) (*alerts.NrqlAlertCondition, error) {
+						return nil, nrerrs.NewNotFound("resource not found")
+					},
+					MockSearchNrqlConditionsQueryWithContext: func(_ context.Context, _ int, _ alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error) {
+						return nil, nil
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"SearchFailed": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockGetNrqlConditionQueryWithContext: func(_ context.Context, _ int, _ string) (*alerts.NrqlAlertCondition, error) {
+						return nil, nrerrs.NewNotFound("resource not found")
+					},
+					MockSearchNrqlConditionsQueryWithContext: func(_ context.Context, _ int, _ alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error) {
+						return nil, errBoom
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+			want: want{id: "1", err: nrerrors.Classify(errBoom)},
+		},
+		"UnrelatedNotFound": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockGetNrqlConditionQueryWithContext: func(_ context.Context, _ int, _ string) (*alerts.NrqlAlertCondition, error) {
+						return nil, errors.New("Account 1234567 not found in region")
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+			want: want{id: "1", err: &nrerrors.Error{Reason: nrerrors.ReasonUnknown, Err: errors.New("Account 1234567 not found in region")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			record := &fake.EventRecorder{}
+			e := external{alerts: tc.args.alerts, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, accountID: 1234567, log: logging.NewNopLogger(), record: record}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			// Whether the condition is up to date is covered by TestIsUpToDate
+			if diff := cmp.Diff(tc.want.o, got, cmpopts.IgnoreFields(managed.ExternalObservation{}, "ResourceUpToDate", "Diff")); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.NrqlAlertCondition); ok {
+				if diff := cmp.Diff(tc.want.id, cr.Spec.ForProvider.ID); diff != "" {
+					t.Errorf("e.Observe(...): -want id, +got id:\n%s\n", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.events, record.Reasons); diff != "" {
+				t.Errorf("e.Observe(...): -want events, +got events:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	created := func(_ context.Context, _ int, _ string, _ alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error) {
+		return nrCondition("42"), nil
+	}
+
+	type args struct {
+		alerts *fake.MockAlertsClient
+		mg     resource.Managed
+	}
+
+	type want struct {
+		o        managed.ExternalCreation
+		id       string
+		policyID string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotNrqlAlertCondition": {
+			args: args{alerts: &fake.MockAlertsClient{}},
+			want: want{err: errors.New(errNotChannel)},
+		},
+		"Static": {
+			args: args{
+				alerts: &fake.MockAlertsClient{MockCreateNrqlConditionStaticMutationWithContext: created},
+				mg:     NrqlAlertCondition(func(cr *v1alpha1.NrqlAlertCondition) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{o: managed.ExternalCreation{ConnectionDetails: GenerateConnectionDetails(nrCondition("42"))}, id: "42", policyID: "1375108"},
+		},
+		"Baseline": {
+			args: args{
+				alerts: &fake.MockAlertsClient{MockCreateNrqlConditionBaselineMutationWithContext: created},
+				mg:     NrqlAlertCondition(withType("BASELINE"), func(cr *v1alpha1.NrqlAlertCondition) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{o: managed.ExternalCreation{ConnectionDetails: GenerateConnectionDetails(nrCondition("42"))}, id: "42", policyID: "1375108"},
+		},
+		"PolicyNotFound": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockCreateNrqlConditionStaticMutationWithContext: func(_ context.Context, _ int, _ string, _ alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error) {
+						return nil, errors.New("Policy with ID 1375108 not found")
+					},
+				},
+				mg: NrqlAlertCondition(func(cr *v1alpha1.NrqlAlertCondition) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{err: &nrerrors.Error{Reason: nrerrors.ReasonUnknown, Err: errors.New("Policy with ID 1375108 not found")}},
+		},
+		"UnrelatedNotFound": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockCreateNrqlConditionStaticMutationWithContext: func(_ context.Context, _ int, _ string, _ alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error) {
+						return nil, errors.New("Account 1234567 not found in region")
+					},
+				},
+				mg: NrqlAlertCondition(func(cr *v1alpha1.NrqlAlertCondition) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{policyID: "1375108", err: &nrerrors.Error{Reason: nrerrors.ReasonUnknown, Err: errors.New("Account 1234567 not found in region")}},
+		},
+		"CreateFailed": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockCreateNrqlConditionStaticMutationWithContext: func(_ context.Context, _ int, _ string, _ alerts.NrqlConditionCreateInput) (*alerts.NrqlAlertCondition, error) {
+						return nil, errBoom
+					},
+				},
+				mg: NrqlAlertCondition(func(cr *v1alpha1.NrqlAlertCondition) { cr.Spec.ForProvider.ID = "" }),
+			},
+			want: want{policyID: "1375108", err: nrerrors.Classify(errBoom)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{alerts: tc.args.alerts, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, accountID: 1234567}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.NrqlAlertCondition); ok {
+				if diff := cmp.Diff(tc.want.id, cr.Spec.ForProvider.ID); diff != "" {
+					t.Errorf("e.Create(...): -want id, +got id:\n%s\n", diff)
+				}
+				if diff := cmp.Diff(tc.want.policyID, cr.Spec.ForProvider.AlertsPolicyID); diff != "" {
+					t.Errorf("e.Create(...): -want policy id, +got policy id:\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	updated := func(_ context.Context, _ int, id string, _ alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error) {
+		return nrCondition(id), nil
+	}
+
+	type args struct {
+		alerts *fake.MockAlertsClient
+		mg     resource.Managed
+	}
+
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"NotNrqlAlertCondition": {
+			args: args{alerts: &fake.MockAlertsClient{}},
+			want: errors.New(errNotChannel),
+		},
+		"Static": {
+			args: args{
+				alerts: &fake.MockAlertsClient{MockUpdateNrqlConditionStaticMutationWithContext: updated},
+				mg:     NrqlAlertCondition(),
+			},
+		},
+		"Baseline": {
+			args: args{
+				alerts: &fake.MockAlertsClient{MockUpdateNrqlConditionBaselineMutationWithContext: updated},
+				mg:     NrqlAlertCondition(withType("BASELINE")),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockUpdateNrqlConditionStaticMutationWithContext: func(_ context.Context, _ int, _ string, _ alerts.NrqlConditionUpdateInput) (*alerts.NrqlAlertCondition, error) {
+						return nil, errBoom
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+			want: nrerrors.Classify(errBoom),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{alerts: tc.args.alerts, kube: &test.MockClient{}, accountID: 1234567}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		alerts *fake.MockAlertsClient
+		mg     resource.Managed
+	}
+
+	cases := map[string]struct {
+		args   args
+		want   error
+		events []event.Reason
+	}{
+		"NotNrqlAlertCondition": {
+			args: args{alerts: &fake.MockAlertsClient{}},
+			want: errors.New(errNotChannel),
+		},
+		"NoID": {
+			args: args{
+				alerts: &fake.MockAlertsClient{},
+				mg:     NrqlAlertCondition(func(cr *v1alpha1.NrqlAlertCondition) { cr.Spec.ForProvider.ID = "" }),
+			},
+			events: []event.Reason{reasonSkippedDelete},
+		},
+		"Deleted": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockDeleteNrqlConditionMutationWithContext: func(_ context.Context, _ int, id string) (string, error) {
+						return id, nil
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockDeleteNrqlConditionMutationWithContext: func(_ context.Context, _ int, _ string) (string, error) {
+						return "", nrerrs.NewNotFound("resource not found")
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				alerts: &fake.MockAlertsClient{
+					MockDeleteNrqlConditionMutationWithContext: func(_ context.Context, _ int, _ string) (string, error) {
+						return "", errBoom
+					},
+				},
+				mg: NrqlAlertCondition(),
+			},
+			want: nrerrors.Classify(errBoom),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			record := &fake.EventRecorder{}
+			e := external{alerts: tc.args.alerts, kube: &test.MockClient{}, accountID: 1234567, log: logging.NewNopLogger(), record: record}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.events, record.Reasons); diff != "" {
+				t.Errorf("e.Delete(...): -want events, +got events:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nrqlquery
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/nrqlquery/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotNrqlQuery = "managed resource is not a NrqlQuery custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errQuery        = "cannot run NRQL query"
+)
+
+const (
+	// defaultMaxRows is the number of rows kept when maxRows is not set
+	defaultMaxRows = 10
+	// maxValueLength bounds the length of a single value kept in the status
+	maxValueLength = 1024
+	// resultsKey is the connection detail holding all rows as JSON
+	resultsKey = "results"
+)
+
+// invalidKeyChars matches the characters a Secret key can't contain
+var invalidKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+
+// Setup adds a controller that reconciles NrqlQuery.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.NrqlQueryGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.NrqlQueryKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.NrqlQueryGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.NrqlQuery{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NrqlQuery)
+	if !ok {
+		return nil, errors.New(errNotNrqlQuery)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client    *newrelic.NewRelic
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NrqlQuery)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNrqlQuery)
+	}
+
+	// The query is run on every poll, the previous results are kept if it fails
+	now := metav1.Now()
+	cr.Status.AtProvider.LastRunTime = &now
+	accountID := pointy.IntValue(cr.Spec.ForProvider.AccountID, c.accountID)
+	result, err := c.client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(cr.Spec.ForProvider.Query))
+	if err != nil {
+		cr.Status.AtProvider.LastError = err.Error()
+		return managed.ExternalObservation{}, errors.Wrap(nrerrors.Classify(err), errQuery)
+	}
+
+	rows := ProjectResults(result.Results, cr.Spec.ForProvider.Fields, pointy.IntValue(cr.Spec.ForProvider.MaxRows, defaultMaxRows))
+	cr.Status.AtProvider.Results = rows
+	cr.Status.AtProvider.RowCount = len(result.Results)
+	cr.Status.AtProvider.LastError = ""
+
+	details, err := GenerateConnectionDetails(rows)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: details,
+	}, nil
+}
+
+// A NrqlQuery only reads from New Relic, so there is nothing to create, update or delete.
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NrqlQuery)
+	if !ok {
+		return errors.New(errNotNrqlQuery)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// ProjectResults keeps the given fields of the first maxRows results, all fields if none
+// are given. Values are converted to strings and truncated to maxValueLength.
+func ProjectResults(results []nrdb.NRDBResult, fields []string, maxRows int) []map[string]string {
+	if len(results) > maxRows {
+		results = results[:maxRows]
+	}
+	rows := make([]map[string]string, 0, len(results))
+	for _, result := range results {
+		row := map[string]string{}
+		for k, v := range result {
+			if len(fields) > 0 && !contains(fields, k) {
+				continue
+			}
+			row[k] = FormatValue(v)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// FormatValue converts a result value to a string. Strings are kept as is, numbers
+// are written without exponent and other values are JSON encoded.
+func FormatValue(v interface{}) string {
+	var s string
+	switch value := v.(type) {
+	case string:
+		s = value
+	case float64:
+		s = strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		out, err := json.Marshal(value)
+		if err != nil {
+			return ""
+		}
+		s = string(out)
+	}
+	if len(s) > maxValueLength {
+		s = s[:maxValueLength]
+	}
+	return s
+}
+
+// GenerateConnectionDetails publishes the fields of the first row, and all rows
+// as JSON under the results key. Field names are turned into valid Secret keys,
+// e.g. "percentile(duration, 95)" is published as "percentile_duration_95".
+func GenerateConnectionDetails(rows []map[string]string) (managed.ConnectionDetails, error) {
+	details := managed.ConnectionDetails{}
+	if len(rows) > 0 {
+		for k, v := range rows[0] {
+			if key := ConnectionDetailKey(k); key != "" {
+				details[key] = []byte(v)
+			}
+		}
+	}
+	out, err := json.Marshal(rows)
+	if err != nil {
+		return nil, err
+	}
+	details[resultsKey] = out
+	return details, nil
+}
+
+// ConnectionDetailKey returns a valid Secret key for a result field name
+func ConnectionDetailKey(field string) string {
+	return strings.Trim(invalidKeyChars.ReplaceAllString(field, "_"), "_")
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
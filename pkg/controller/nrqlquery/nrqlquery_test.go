@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nrqlquery
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+)
+
+func TestProjectResults(t *testing.T) {
+
+	type args struct {
+		results []nrdb.NRDBResult
+		fields  []string
+		maxRows int
+	}
+
+	cases := map[string]struct {
+		args args
+		want []map[string]string
+	}{
+		"AllFields": {
+			args: args{
+				results: []nrdb.NRDBResult{{"count": float64(1500000), "appName": "checkout"}},
+				maxRows: 10,
+			},
+			want: []map[string]string{{"count": "1500000", "appName": "checkout"}},
+		},
+		"SelectedFields": {
+			args: args{
+				results: []nrdb.NRDBResult{{"count": float64(12), "appName": "checkout"}},
+				fields:  []string{"appName"},
+				maxRows: 10,
+			},
+			want: []map[string]string{{"appName": "checkout"}},
+		},
+		"MaxRows": {
+			args: args{
+				results: []nrdb.NRDBResult{{"facet": "a"}, {"facet": "b"}, {"facet": "c"}},
+				maxRows: 2,
+			},
+			want: []map[string]string{{"facet": "a"}, {"facet": "b"}},
+		},
+		"NestedValue": {
+			args: args{
+				results: []nrdb.NRDBResult{{"percentile.duration": map[string]interface{}{"95": 0.25}}},
+				maxRows: 10,
+			},
+			want: []map[string]string{{"percentile.duration": `{"95":0.25}`}},
+		},
+		"LongValue": {
+			args: args{
+				results: []nrdb.NRDBResult{{"message": strings.Repeat("x", maxValueLength+10)}},
+				maxRows: 10,
+			},
+			want: []map[string]string{{"message": strings.Repeat("x", maxValueLength)}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ProjectResults(tc.args.results, tc.args.fields, tc.args.maxRows)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestProjectResults(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateConnectionDetails(t *testing.T) {
+
+	cases := map[string]struct {
+		rows []map[string]string
+		want managed.ConnectionDetails
+	}{
+		"NoRows": {
+			rows: []map[string]string{},
+			want: managed.ConnectionDetails{"results": []byte(`[]`)},
+		},
+		"FirstRow": {
+			rows: []map[string]string{{"percentile(duration, 95)": "0.25"}, {"percentile(duration, 95)": "0.5"}},
+			want: managed.ConnectionDetails{
+				"percentile_duration_95": []byte("0.25"),
+				"results":                []byte(`[{"percentile(duration, 95)":"0.25"},{"percentile(duration, 95)":"0.5"}]`),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateConnectionDetails(tc.rows)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestGenerateConnectionDetails(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,401 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolegrant
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/authorizationmanagement"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/usermanagement/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotRoleGrant = "managed resource is not a RoleGrant custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errGetPC        = "cannot get ProviderConfig"
+	errExternalName = "external name must be of the form <groupId>:<roleId>:<accountId>"
+	errRoleNotFound = "role %q not found"
+)
+
+// Setup adds a controller that reconciles RoleGrant.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RoleGrantGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.RoleGrantKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RoleGrantGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.RoleGrant{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RoleGrant)
+	if !ok {
+		return nil, errors.New(errNotRoleGrant)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client    *newrelic.NewRelic
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RoleGrant)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRoleGrant)
+	}
+
+	desired, err := c.desiredGrant(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Without an external name an existing grant is adopted
+	current := desired
+	if name := meta.GetExternalName(cr); name != "" {
+		if current, err = ParseExternalName(name); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	roles, err := GetGroupRoles(ctx, c.client, current.GroupID)
+	if err != nil {
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
+	}
+	role := FindGrantedRole(roles, current)
+	if role == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		meta.SetExternalName(cr, current.String())
+		_ = c.kube.Update(ctx, cr)
+	}
+
+	// Update the status
+	cr.Status.AtProvider = v1alpha1.RoleGrantObservation{
+		RoleID:      current.RoleID,
+		AccountID:   role.AccountID,
+		DisplayName: role.DisplayName,
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  IsUpToDate(cr, desired),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RoleGrant)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRoleGrant)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	desired, err := c.desiredGrant(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := c.grant(ctx, desired); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, desired.String())
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RoleGrant)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRoleGrant)
+	}
+
+	// Grants can't be changed, so the old grant is replaced
+	current, err := ParseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	desired, err := c.desiredGrant(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := c.grant(ctx, desired); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	meta.SetExternalName(cr, desired.String())
+	if err := c.revoke(ctx, current); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RoleGrant)
+	if !ok {
+		return errors.New(errNotRoleGrant)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if meta.GetExternalName(cr) == "" {
+		return nil
+	}
+	current, err := ParseExternalName(meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+
+	return c.revoke(ctx, current)
+}
+
+// desiredGrant returns the grant described by the spec. The role is looked up by name
+// when no role ID is set, and the account defaults to the account of the ProviderConfig.
+func (c *external) desiredGrant(ctx context.Context, cr *v1alpha1.RoleGrant) (Grant, error) {
+	grant := Grant{
+		GroupID:   pointy.StringValue(cr.Spec.ForProvider.GroupID, ""),
+		RoleID:    pointy.StringValue(cr.Spec.ForProvider.RoleID, ""),
+		AccountID: pointy.IntValue(cr.Spec.ForProvider.AccountID, c.accountID),
+	}
+	if grant.RoleID != "" {
+		return grant, nil
+	}
+
+	name := pointy.StringValue(cr.Spec.ForProvider.RoleName, "")
+	cursor := ""
+	for {
+		roles, err := c.client.AuthorizationManagement.GetRolesWithContext(ctx, cursor, nil)
+		if err != nil {
+			return grant, nrerrors.Classify(err)
+		}
+		for _, role := range roles.Roles {
+			if role.Name == name {
+				grant.RoleID = role.ID
+				return grant, nil
+			}
+		}
+		if roles.NextCursor == "" {
+			return grant, errors.Errorf(errRoleNotFound, name)
+		}
+		cursor = roles.NextCursor
+	}
+}
+
+func (c *external) grant(ctx context.Context, grant Grant) error {
+	input := authorizationmanagement.AuthorizationManagementGrantAccess{
+		GroupId:             grant.GroupID,
+		AccountAccessGrants: []authorizationmanagement.AuthorizationManagementAccountAccessGrant{{AccountID: grant.AccountID, RoleId: grant.RoleID}},
+	}
+	_, err := c.client.AuthorizationManagement.AuthorizationManagementGrantAccessWithContext(ctx, input)
+	return nrerrors.Classify(err)
+}
+
+func (c *external) revoke(ctx context.Context, grant Grant) error {
+	input := authorizationmanagement.AuthorizationManagementRevokeAccess{
+		GroupId:             grant.GroupID,
+		AccountAccessGrants: []authorizationmanagement.AuthorizationManagementAccountAccessGrant{{AccountID: grant.AccountID, RoleId: grant.RoleID}},
+	}
+	_, err := c.client.AuthorizationManagement.AuthorizationManagementRevokeAccessWithContext(ctx, input)
+	return nrerrors.Classify(err)
+}
+
+// Grant identifies a role granted to a group on an account
+type Grant struct {
+	GroupID   string
+	RoleID    string
+	AccountID int
+}
+
+// String returns the external name of the grant
+func (g Grant) String() string {
+	return fmt.Sprintf("%s:%s:%d", g.GroupID, g.RoleID, g.AccountID)
+}
+
+// ParseExternalName returns the grant identified by an external name
+func ParseExternalName(name string) (Grant, error) {
+	parts := strings.Split(name, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return Grant{}, errors.New(errExternalName)
+	}
+	accountID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return Grant{}, errors.New(errExternalName)
+	}
+	return Grant{GroupID: parts[0], RoleID: parts[1], AccountID: accountID}, nil
+}
+
+// FindGrantedRole returns the granted role matching the grant, or nil
+func FindGrantedRole(roles []authorizationmanagement.AuthorizationManagementGrantedRole, grant Grant) *authorizationmanagement.AuthorizationManagementGrantedRole {
+	for i := range roles {
+		if strconv.Itoa(roles[i].RoleId) == grant.RoleID && roles[i].AccountID == grant.AccountID {
+			return &roles[i]
+		}
+	}
+	return nil
+}
+
+// IsUpToDate determines whether the granted role still matches the desired grant
+func IsUpToDate(p *v1alpha1.RoleGrant, desired Grant) bool {
+	return meta.GetExternalName(p) == desired.String()
+}
+
+// Reading the roles granted to a group is not part of newrelic-client-go yet, so it is
+// done through a raw NerdGraph request.
+const getGroupRolesQuery = `query($groupId: [ID!]) {
+	actor { organization { authorizationManagement { authenticationDomains {
+		authenticationDomains { groups(id: $groupId) { groups { id roles { roles {
+			accountId
+			displayName
+			groupId
+			id
+			name
+			organizationId
+			roleId
+		} } } } }
+	} } } }
+}`
+
+// GetGroupRoles returns the roles granted to a group
+func GetGroupRoles(ctx context.Context, client *newrelic.NewRelic, groupID string) ([]authorizationmanagement.AuthorizationManagementGrantedRole, error) {
+	resp := struct {
+		Actor struct {
+			Organization struct {
+				AuthorizationManagement struct {
+					AuthenticationDomains struct {
+						AuthenticationDomains []struct {
+							Groups struct {
+								Groups []struct {
+									ID    string `json:"id"`
+									Roles struct {
+										Roles []authorizationmanagement.AuthorizationManagementGrantedRole `json:"roles"`
+									} `json:"roles"`
+								} `json:"groups"`
+							} `json:"groups"`
+						} `json:"authenticationDomains"`
+					} `json:"authenticationDomains"`
+				} `json:"authorizationManagement"`
+			} `json:"organization"`
+		} `json:"actor"`
+	}{}
+	vars := map[string]interface{}{
+		"groupId": []string{groupID},
+	}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, getGroupRolesQuery, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	roles := []authorizationmanagement.AuthorizationManagementGrantedRole{}
+	for _, domain := range resp.Actor.Organization.AuthorizationManagement.AuthenticationDomains.AuthenticationDomains {
+		for _, group := range domain.Groups.Groups {
+			if group.ID == groupID {
+				roles = append(roles, group.Roles.Roles...)
+			}
+		}
+	}
+	return roles, nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolegrant
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/authorizationmanagement"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/usermanagement/v1alpha1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		externalName string
+		desired      Grant
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"DiffRole": {
+			args: args{externalName: "20b1c2d3:1254:1234567", desired: Grant{GroupID: "20b1c2d3", RoleID: "1253", AccountID: 1234567}},
+			want: false,
+		},
+		"DiffAccount": {
+			args: args{externalName: "20b1c2d3:1254:1234567", desired: Grant{GroupID: "20b1c2d3", RoleID: "1254", AccountID: 7654321}},
+			want: false,
+		},
+		"Same": {
+			args: args{externalName: "20b1c2d3:1254:1234567", desired: Grant{GroupID: "20b1c2d3", RoleID: "1254", AccountID: 1234567}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.RoleGrant{}
+			meta.SetExternalName(cr, tc.args.externalName)
+			got := IsUpToDate(cr, tc.args.desired)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestFindGrantedRole(t *testing.T) {
+	roles := []authorizationmanagement.AuthorizationManagementGrantedRole{
+		{ID: "a", RoleId: 1253, AccountID: 1234567, DisplayName: "All Product Admin"},
+		{ID: "b", RoleId: 1254, AccountID: 7654321, DisplayName: "Standard User"},
+	}
+
+	cases := map[string]struct {
+		grant Grant
+		want  string
+	}{
+		"Found":       {grant: Grant{GroupID: "20b1c2d3", RoleID: "1254", AccountID: 7654321}, want: "b"},
+		"DiffAccount": {grant: Grant{GroupID: "20b1c2d3", RoleID: "1254", AccountID: 1234567}, want: ""},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ""
+			if role := FindGrantedRole(roles, tc.grant); role != nil {
+				got = role.ID
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestFindGrantedRole(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
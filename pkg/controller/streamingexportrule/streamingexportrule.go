@@ -0,0 +1,637 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamingexportrule
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/streamingexportrule/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/features"
+)
+
+const (
+	errNotStreamingExportRule = "managed resource is not a StreamingExportRule custom resource"
+	errTrackPCUsage           = "cannot track ProviderConfig usage"
+	errGetPC                  = "cannot get ProviderConfig"
+	errNoDestination          = "destination %s is not configured"
+	errNoRole                 = "either role or roleSecretRef must be set"
+	errGetSecret              = "cannot get destination secret"
+	errRuleNotFound           = "streaming export rule %s not found"
+
+	destinationKinesisFirehose = "KINESIS_FIREHOSE"
+	destinationEventHub        = "EVENT_HUB"
+	destinationPubSub          = "PUB_SUB"
+
+	statusEnabled            = "ENABLED"
+	statusDisabled           = "DISABLED"
+	statusCreationInProgress = "CREATION_IN_PROGRESS"
+	statusCreationFailed     = "CREATION_FAILED"
+	statusDeleted            = "DELETED"
+)
+
+// Setup adds a controller that reconciles StreamingExportRule.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.StreamingExportRuleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(nr.ESSTLSConfig(o))))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(nr.InstrumentConnecter(v1alpha1.StreamingExportRuleKind, &connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		})),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.StreamingExportRuleGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.StreamingExportRule{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.StreamingExportRule)
+	if !ok {
+		return nil, errors.New(errNotStreamingExportRule)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	// Get the account id from the provider config
+	accountID, err := nr.ResolveAccountID(pc, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a client using NR Credentials
+	nrClient, err := nr.ExtractNewRelicCredentials(ctx, c.kube, pc, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: nrClient, kube: c.kube, accountID: accountID}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client    *newrelic.NewRelic
+	kube      client.Client
+	accountID int
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.StreamingExportRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotStreamingExportRule)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rule, err := GetStreamingExportRule(ctx, c.client, c.accountID, id)
+	if err != nil {
+		if nrerrors.IsNotFound(err) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		return managed.ExternalObservation{}, nrerrors.Classify(err)
+	}
+	if rule == nil || rule.ID == "" || rule.Status == statusDeleted {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Update the status
+	cr.Status.AtProvider = v1alpha1.StreamingExportRuleObservation{
+		ID:      rule.ID,
+		Status:  rule.Status,
+		Message: rule.Message,
+	}
+
+	switch rule.Status {
+	case statusCreationInProgress:
+		// Rules can't be changed until they are created
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case statusCreationFailed:
+		// Changing the rule won't help, it has to be recreated
+		cr.SetConditions(xpv1.Unavailable().WithMessage(rule.Message))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	destination, err := c.GenerateDestination(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  IsUpToDate(cr, destination, *rule),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.StreamingExportRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotStreamingExportRule)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	destination, err := c.GenerateDestination(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	rule, err := CreateStreamingExportRule(ctx, c.client, c.accountID, GenerateRuleInput(cr), destination)
+	if err != nil {
+		return managed.ExternalCreation{}, nrerrors.Classify(err)
+	}
+
+	// The rule ID is the identity of the rule. New rules are enabled once created,
+	// disabling them is left to Update.
+	meta.SetExternalName(cr, rule.ID)
+	cr.Status.AtProvider = v1alpha1.StreamingExportRuleObservation{
+		ID:      rule.ID,
+		Status:  rule.Status,
+		Message: rule.Message,
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.StreamingExportRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotStreamingExportRule)
+	}
+
+	id := meta.GetExternalName(cr)
+	rule, err := GetStreamingExportRule(ctx, c.client, c.accountID, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, nrerrors.Classify(err)
+	}
+	// The rule may have been deleted since it was observed
+	if rule == nil || rule.ID == "" || rule.Status == statusDeleted {
+		return managed.ExternalUpdate{}, &nrerrors.Error{Reason: nrerrors.ReasonNotFound, Err: errors.Errorf(errRuleNotFound, id)}
+	}
+	destination, err := c.GenerateDestination(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// The definition and the enabled state are changed by separate mutations,
+	// so toggling a rule doesn't resend its NRQL and destination
+	if !DefinitionIsUpToDate(cr, destination, *rule) {
+		if _, err := UpdateStreamingExportRule(ctx, c.client, id, GenerateRuleInput(cr), destination); err != nil {
+			return managed.ExternalUpdate{}, nrerrors.Classify(err)
+		}
+	}
+
+	if !EnabledIsUpToDate(cr, *rule) {
+		if pointy.BoolValue(cr.Spec.ForProvider.Enabled, true) {
+			_, err = EnableStreamingExportRule(ctx, c.client, id)
+		} else {
+			_, err = DisableStreamingExportRule(ctx, c.client, id)
+		}
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nrerrors.Classify(err)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.StreamingExportRule)
+	if !ok {
+		return errors.New(errNotStreamingExportRule)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return nil
+	}
+
+	return nrerrors.IgnoreNotFound(DeleteStreamingExportRule(ctx, c.client, id))
+}
+
+// GenerateDestination produces the destination parameters of the rule, reading the
+// values kept in secrets
+func (c *external) GenerateDestination(ctx context.Context, cr *v1alpha1.StreamingExportRule) (StreamingExportDestination, error) {
+	d := cr.Spec.ForProvider.Destination
+	destination := StreamingExportDestination{}
+
+	switch d.Type {
+	case destinationKinesisFirehose:
+		if d.KinesisFirehose == nil {
+			return destination, errors.Errorf(errNoDestination, d.Type)
+		}
+		role := d.KinesisFirehose.Role
+		if d.KinesisFirehose.RoleSecretRef != nil {
+			value, err := c.GetSecretValue(ctx, d.KinesisFirehose.RoleSecretRef)
+			if err != nil {
+				return destination, err
+			}
+			role = value
+		}
+		if role == "" {
+			return destination, errors.New(errNoRole)
+		}
+		destination.AwsParameters = &StreamingExportAwsParameters{
+			AwsAccountID:       d.KinesisFirehose.AwsAccountID,
+			DeliveryStreamName: d.KinesisFirehose.DeliveryStreamName,
+			Region:             d.KinesisFirehose.Region,
+			Role:               role,
+		}
+	case destinationEventHub:
+		if d.EventHub == nil {
+			return destination, errors.Errorf(errNoDestination, d.Type)
+		}
+		connectionString, err := c.GetSecretValue(ctx, &d.EventHub.ConnectionStringSecretRef)
+		if err != nil {
+			return destination, err
+		}
+		destination.AzureParameters = &StreamingExportAzureParameters{
+			EventHubConnectionString: connectionString,
+			EventHubName:             d.EventHub.EventHubName,
+		}
+	case destinationPubSub:
+		if d.PubSub == nil {
+			return destination, errors.Errorf(errNoDestination, d.Type)
+		}
+		destination.GcpParameters = &StreamingExportGcpParameters{
+			GcpProjectID:  d.PubSub.ProjectID,
+			PubsubTopicID: d.PubSub.TopicID,
+		}
+	}
+	return destination, nil
+}
+
+// GetSecretValue reads the value of a secret key
+func (c *external) GetSecretValue(ctx context.Context, ref *xpv1.SecretKeySelector) (string, error) {
+	data, err := resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceSecret, c.kube, xpv1.CommonCredentialSelectors{
+		SecretRef: ref,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// GenerateRuleInput produces the rule parameters from the spec
+func GenerateRuleInput(cr *v1alpha1.StreamingExportRule) StreamingExportRuleInput {
+	return StreamingExportRuleInput{
+		Description: pointy.StringValue(cr.Spec.ForProvider.Description, ""),
+		Name:        cr.Spec.ForProvider.Name,
+		Nrql:        cr.Spec.ForProvider.Nrql,
+	}
+}
+
+// IsUpToDate determines whether the StreamingExportRule needs to be updated
+func IsUpToDate(p *v1alpha1.StreamingExportRule, destination StreamingExportDestination, rule StreamingExportRuleEntity) bool {
+	return DefinitionIsUpToDate(p, destination, rule) && EnabledIsUpToDate(p, rule)
+}
+
+// DefinitionIsUpToDate determines whether the NRQL, name, description or destination of the
+// rule changed. The Event Hub connection string can't be read back so it is not compared.
+func DefinitionIsUpToDate(p *v1alpha1.StreamingExportRule, destination StreamingExportDestination, rule StreamingExportRuleEntity) bool {
+	if !cmp.Equal(p.Spec.ForProvider.Name, rule.Name, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(pointy.StringValue(p.Spec.ForProvider.Description, ""), rule.Description, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(p.Spec.ForProvider.Nrql, rule.Nrql) {
+		return false
+	}
+	if !cmp.Equal(destination.AwsParameters, rule.AwsParameters) {
+		return false
+	}
+	if !cmp.Equal(destination.GcpParameters, rule.GcpParameters) {
+		return false
+	}
+	return cmp.Equal(destination.AzureParameters, rule.AzureParameters,
+		cmpopts.IgnoreFields(StreamingExportAzureParameters{}, "EventHubConnectionString"))
+}
+
+// EnabledIsUpToDate determines whether the rule needs to be enabled or disabled
+func EnabledIsUpToDate(p *v1alpha1.StreamingExportRule, rule StreamingExportRuleEntity) bool {
+	if pointy.BoolValue(p.Spec.ForProvider.Enabled, true) {
+		return rule.Status != statusDisabled
+	}
+	return rule.Status != statusEnabled
+}
+
+// The streaming export API is not part of newrelic-client-go yet, so it is used
+// through raw NerdGraph requests.
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/nerdgraph-streaming-export/
+
+// StreamingExportRuleInput are the rule parameters of the streaming export mutations
+type StreamingExportRuleInput struct {
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name"`
+	Nrql        string `json:"nrql"`
+}
+
+// StreamingExportAwsParameters is a Kinesis Data Firehose destination
+type StreamingExportAwsParameters struct {
+	AwsAccountID       string `json:"awsAccountId"`
+	DeliveryStreamName string `json:"deliveryStreamName"`
+	Region             string `json:"region"`
+	Role               string `json:"role"`
+}
+
+// StreamingExportAzureParameters is an Event Hub destination
+type StreamingExportAzureParameters struct {
+	EventHubConnectionString string `json:"eventHubConnectionString,omitempty"`
+	EventHubName             string `json:"eventHubName"`
+}
+
+// StreamingExportGcpParameters is a Pub/Sub destination
+type StreamingExportGcpParameters struct {
+	GcpProjectID  string `json:"gcpProjectId"`
+	PubsubTopicID string `json:"pubsubTopicId"`
+}
+
+// StreamingExportDestination holds the parameters of the destination, only one is set
+type StreamingExportDestination struct {
+	AwsParameters   *StreamingExportAwsParameters
+	AzureParameters *StreamingExportAzureParameters
+	GcpParameters   *StreamingExportGcpParameters
+}
+
+// StreamingExportRuleEntity is a streaming export rule as returned by NerdGraph
+type StreamingExportRuleEntity struct {
+	ID              string                          `json:"id"`
+	Name            string                          `json:"name"`
+	Description     string                          `json:"description"`
+	Nrql            string                          `json:"nrql"`
+	Status          string                          `json:"status"`
+	Message         string                          `json:"message"`
+	AwsParameters   *StreamingExportAwsParameters   `json:"awsParameters"`
+	AzureParameters *StreamingExportAzureParameters `json:"azureParameters"`
+	GcpParameters   *StreamingExportGcpParameters   `json:"gcpParameters"`
+}
+
+const streamingExportRuleFields = `
+	id
+	name
+	description
+	nrql
+	status
+	message
+	awsParameters {
+		awsAccountId
+		deliveryStreamName
+		region
+		role
+	}
+	azureParameters {
+		eventHubName
+	}
+	gcpParameters {
+		gcpProjectId
+		pubsubTopicId
+	}`
+
+const getStreamingExportRuleQuery = `query($accountId: Int!, $id: ID!) {
+	actor {
+		account(id: $accountId) {
+			streamingExport {
+				streamingRule(id: $id) {` + streamingExportRuleFields + `
+				}
+			}
+		}
+	}
+}`
+
+const streamingExportCreateRuleMutation = `mutation(
+	$accountId: Int!,
+	$ruleParameters: StreamingExportRuleInput!,
+	$awsParameters: StreamingExportAwsInput,
+	$azureParameters: StreamingExportAzureInput,
+	$gcpParameters: StreamingExportGcpInput,
+) { streamingExportCreateRule(
+	accountId: $accountId,
+	ruleParameters: $ruleParameters,
+	awsParameters: $awsParameters,
+	azureParameters: $azureParameters,
+	gcpParameters: $gcpParameters,
+) {` + streamingExportRuleFields + `
+} }`
+
+const streamingExportUpdateRuleMutation = `mutation(
+	$id: ID!,
+	$ruleParameters: StreamingExportRuleInput!,
+	$awsParameters: StreamingExportAwsInput,
+	$azureParameters: StreamingExportAzureInput,
+	$gcpParameters: StreamingExportGcpInput,
+) { streamingExportUpdateRule(
+	id: $id,
+	ruleParameters: $ruleParameters,
+	awsParameters: $awsParameters,
+	azureParameters: $azureParameters,
+	gcpParameters: $gcpParameters,
+) {` + streamingExportRuleFields + `
+} }`
+
+const streamingExportEnableRuleMutation = `mutation($id: ID!) {
+	streamingExportEnableRule(id: $id) {
+		id
+		status
+		message
+	}
+}`
+
+const streamingExportDisableRuleMutation = `mutation($id: ID!) {
+	streamingExportDisableRule(id: $id) {
+		id
+		status
+		message
+	}
+}`
+
+const streamingExportDeleteRuleMutation = `mutation($id: ID!) {
+	streamingExportDeleteRule(id: $id) {
+		id
+		status
+	}
+}`
+
+// destinationVars adds the destination parameters to the variables of a mutation
+func destinationVars(vars map[string]interface{}, destination StreamingExportDestination) map[string]interface{} {
+	if destination.AwsParameters != nil {
+		vars["awsParameters"] = destination.AwsParameters
+	}
+	if destination.AzureParameters != nil {
+		vars["azureParameters"] = destination.AzureParameters
+	}
+	if destination.GcpParameters != nil {
+		vars["gcpParameters"] = destination.GcpParameters
+	}
+	return vars
+}
+
+// GetStreamingExportRule fetches a streaming export rule by ID
+func GetStreamingExportRule(ctx context.Context, client *newrelic.NewRelic, accountID int, id string) (*StreamingExportRuleEntity, error) {
+	resp := struct {
+		Actor struct {
+			Account struct {
+				StreamingExport struct {
+					StreamingRule *StreamingExportRuleEntity `json:"streamingRule"`
+				} `json:"streamingExport"`
+			} `json:"account"`
+		} `json:"actor"`
+	}{}
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"id":        id,
+	}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, getStreamingExportRuleQuery, vars, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Actor.Account.StreamingExport.StreamingRule, nil
+}
+
+// CreateStreamingExportRule creates a streaming export rule
+func CreateStreamingExportRule(ctx context.Context, client *newrelic.NewRelic, accountID int, input StreamingExportRuleInput, destination StreamingExportDestination) (*StreamingExportRuleEntity, error) {
+	resp := struct {
+		StreamingExportCreateRule StreamingExportRuleEntity `json:"streamingExportCreateRule"`
+	}{}
+	vars := destinationVars(map[string]interface{}{
+		"accountId":      accountID,
+		"ruleParameters": input,
+	}, destination)
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, streamingExportCreateRuleMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.StreamingExportCreateRule, nil
+}
+
+// UpdateStreamingExportRule updates the definition of a streaming export rule
+func UpdateStreamingExportRule(ctx context.Context, client *newrelic.NewRelic, id string, input StreamingExportRuleInput, destination StreamingExportDestination) (*StreamingExportRuleEntity, error) {
+	resp := struct {
+		StreamingExportUpdateRule StreamingExportRuleEntity `json:"streamingExportUpdateRule"`
+	}{}
+	vars := destinationVars(map[string]interface{}{
+		"id":             id,
+		"ruleParameters": input,
+	}, destination)
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, streamingExportUpdateRuleMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.StreamingExportUpdateRule, nil
+}
+
+// EnableStreamingExportRule starts exporting data for a rule
+func EnableStreamingExportRule(ctx context.Context, client *newrelic.NewRelic, id string) (*StreamingExportRuleEntity, error) {
+	resp := struct {
+		StreamingExportEnableRule StreamingExportRuleEntity `json:"streamingExportEnableRule"`
+	}{}
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, streamingExportEnableRuleMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.StreamingExportEnableRule, nil
+}
+
+// DisableStreamingExportRule stops exporting data for a rule
+func DisableStreamingExportRule(ctx context.Context, client *newrelic.NewRelic, id string) (*StreamingExportRuleEntity, error) {
+	resp := struct {
+		StreamingExportDisableRule StreamingExportRuleEntity `json:"streamingExportDisableRule"`
+	}{}
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, streamingExportDisableRuleMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.StreamingExportDisableRule, nil
+}
+
+// DeleteStreamingExportRule deletes a streaming export rule
+func DeleteStreamingExportRule(ctx context.Context, client *newrelic.NewRelic, id string) error {
+	resp := struct {
+		StreamingExportDeleteRule StreamingExportRuleEntity `json:"streamingExportDeleteRule"`
+	}{}
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	return client.NerdGraph.QueryWithResponseAndContext(ctx, streamingExportDeleteRuleMutation, vars, &resp)
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamingexportrule
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/pkg/errors"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/streamingexportrule/v1alpha1"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+)
+
+type ruleModifier func(*v1alpha1.StreamingExportRule)
+
+func rule(m ...ruleModifier) *v1alpha1.StreamingExportRule {
+	cr := &v1alpha1.StreamingExportRule{
+		Spec: v1alpha1.StreamingExportRuleSpec{
+			ForProvider: v1alpha1.StreamingExportRuleParameters{
+				Name:        "transactions",
+				Description: pointy.String("Transactions for the data lake"),
+				Nrql:        "SELECT * FROM Transaction",
+				Destination: v1alpha1.StreamingExportDestination{
+					Type: "EVENT_HUB",
+					EventHub: &v1alpha1.EventHubDestination{
+						EventHubName: "newrelic-export",
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, "7f5c2b5e-6c7a-4a1f-9a83-2d9d1f0c6e11")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func destination() StreamingExportDestination {
+	return StreamingExportDestination{
+		AzureParameters: &StreamingExportAzureParameters{
+			EventHubConnectionString: "Endpoint=sb://example.servicebus.windows.net/",
+			EventHubName:             "newrelic-export",
+		},
+	}
+}
+
+func entity() StreamingExportRuleEntity {
+	return StreamingExportRuleEntity{
+		ID:          "7f5c2b5e-6c7a-4a1f-9a83-2d9d1f0c6e11",
+		Name:        "transactions",
+		Description: "Transactions for the data lake",
+		Nrql:        "SELECT * FROM Transaction",
+		Status:      "ENABLED",
+		AzureParameters: &StreamingExportAzureParameters{
+			EventHubName: "newrelic-export",
+		},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr          v1alpha1.StreamingExportRule
+		destination StreamingExportDestination
+		nr          StreamingExportRuleEntity
+	}
+
+	type want struct {
+		definition bool
+		enabled    bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DiffNrql": {
+			args: args{cr: *rule(func(cr *v1alpha1.StreamingExportRule) {
+				cr.Spec.ForProvider.Nrql = "SELECT * FROM Transaction WHERE appName = 'checkout'"
+			}),
+				destination: destination(),
+				nr:          entity(),
+			},
+			want: want{definition: false, enabled: true},
+		},
+		"DiffDescription": {
+			args: args{cr: *rule(func(cr *v1alpha1.StreamingExportRule) {
+				cr.Spec.ForProvider.Description = nil
+			}),
+				destination: destination(),
+				nr:          entity(),
+			},
+			want: want{definition: false, enabled: true},
+		},
+		"DiffDestination": {
+			args: args{cr: *rule(),
+				destination: StreamingExportDestination{
+					AzureParameters: &StreamingExportAzureParameters{EventHubName: "other"},
+				},
+				nr: entity(),
+			},
+			want: want{definition: false, enabled: true},
+		},
+		"Disabled": {
+			args: args{cr: *rule(func(cr *v1alpha1.StreamingExportRule) {
+				cr.Spec.ForProvider.Enabled = pointy.Bool(false)
+			}),
+				destination: destination(),
+				nr:          entity(),
+			},
+			want: want{definition: true, enabled: false},
+		},
+		"Same": {
+			args: args{cr: *rule(),
+				destination: destination(),
+				nr:          entity(),
+			},
+			want: want{definition: true, enabled: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{
+				definition: DefinitionIsUpToDate(&tc.args.cr, tc.args.destination, tc.args.nr),
+				enabled:    EnabledIsUpToDate(&tc.args.cr, tc.args.nr),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.definition && tc.want.enabled, IsUpToDate(&tc.args.cr, tc.args.destination, tc.args.nr)); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	deleted := entity()
+	deleted.Status = statusDeleted
+
+	cases := map[string]struct {
+		rule *StreamingExportRuleEntity
+		want error
+	}{
+		"RuleNotFound": {
+			rule: nil,
+			want: &nrerrors.Error{Reason: nrerrors.ReasonNotFound, Err: errors.Errorf(errRuleNotFound, "7f5c2b5e-6c7a-4a1f-9a83-2d9d1f0c6e11")},
+		},
+		"RuleDeleted": {
+			rule: &deleted,
+			want: &nrerrors.Error{Reason: nrerrors.ReasonNotFound, Err: errors.Errorf(errRuleNotFound, "7f5c2b5e-6c7a-4a1f-9a83-2d9d1f0c6e11")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// NerdGraph answers the rule query, which is the only request expected
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				resp := map[string]interface{}{"data": map[string]interface{}{"actor": map[string]interface{}{"account": map[string]interface{}{
+					"streamingExport": map[string]interface{}{"streamingRule": tc.rule},
+				}}}}
+				_ = json.NewEncoder(w).Encode(resp)
+			}))
+			defer srv.Close()
+
+			client, err := newrelic.New(newrelic.ConfigPersonalAPIKey("NRAK-TEST"), newrelic.ConfigNerdGraphBaseURL(srv.URL+"/graphql"))
+			if err != nil {
+				t.Fatalf("newrelic.New(...): %v", err)
+			}
+			e := external{client: client, accountID: 1234567}
+			_, err = e.Update(context.Background(), rule())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -20,10 +20,26 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/account"
 	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/alertspolicy"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/cloudawsintegrations"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/cloudawslinkaccount"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/cloudazureintegrations"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/cloudazurelinkaccount"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/cloudgcpintegrations"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/cloudgcplinkaccount"
 	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/dashboard"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/entitylookup"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/group"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/groupmembership"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/keytransaction"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/lookuptable"
 	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/nrqlalertcondition"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/nrqlquery"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/rolegrant"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/streamingexportrule"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller/user"
 )
 
 // Setup creates all Template controllers with the supplied logger and adds them to
@@ -31,9 +47,26 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		config.Setup,
+		config.SetupStoreConfig,
 		dashboard.Setup,
 		nrqlalertcondition.Setup,
 		alertspolicy.Setup,
+		keytransaction.Setup,
+		cloudawslinkaccount.Setup,
+		cloudawsintegrations.Setup,
+		cloudgcplinkaccount.Setup,
+		cloudgcpintegrations.Setup,
+		cloudazurelinkaccount.Setup,
+		cloudazureintegrations.Setup,
+		streamingexportrule.Setup,
+		lookuptable.Setup,
+		user.Setup,
+		group.Setup,
+		groupmembership.Setup,
+		rolegrant.Setup,
+		account.Setup,
+		nrqlquery.Setup,
+		entitylookup.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
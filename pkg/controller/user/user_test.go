@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/usermanagement"
+	"go.openly.dev/pointy"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis/usermanagement/v1alpha1"
+)
+
+type userModifier func(*v1alpha1.User)
+
+func user(m ...userModifier) *v1alpha1.User {
+	cr := &v1alpha1.User{
+		Spec: v1alpha1.UserSpec{
+			ForProvider: v1alpha1.UserParameters{
+				AuthenticationDomainID: "10a1b2c3-0000-4000-8000-000000000001",
+				Email:                  "jane.doe@example.com",
+				Name:                   "Jane Doe",
+				UserType:               "CORE_USER_TIER",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() usermanagement.UserManagementUser {
+	return usermanagement.UserManagementUser{
+		ID:       "1000123456",
+		Email:    "Jane.Doe@example.com",
+		Name:     "Jane Doe",
+		TimeZone: "Etc/UTC",
+		Type:     usermanagement.UserManagementUserType{DisplayName: "Core"},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+
+	type args struct {
+		cr v1alpha1.User
+		nr usermanagement.UserManagementUser
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"DiffName": {
+			args: args{cr: *user(func(cr *v1alpha1.User) {
+				cr.Spec.ForProvider.Name = "Jane Smith"
+			}),
+				nr: observed(),
+			},
+			want: false,
+		},
+		"DiffTimeZone": {
+			args: args{cr: *user(func(cr *v1alpha1.User) {
+				cr.Spec.ForProvider.TimeZone = pointy.String("Europe/Berlin")
+			}),
+				nr: observed(),
+			},
+			want: false,
+		},
+		"DiffUserType": {
+			args: args{cr: *user(func(cr *v1alpha1.User) {
+				cr.Spec.ForProvider.UserType = "FULL_USER_TIER"
+			}),
+				nr: observed(),
+			},
+			want: false,
+		},
+		"PendingUpgrade": {
+			args: args{cr: *user(func(cr *v1alpha1.User) {
+				cr.Spec.ForProvider.UserType = "FULL_USER_TIER"
+			}),
+				nr: func() usermanagement.UserManagementUser {
+					u := observed()
+					u.PendingUpgradeRequest.RequestedUserType.DisplayName = "Full"
+					return u
+				}(),
+			},
+			want: true,
+		},
+		"Same": {
+			args: args{cr: *user(),
+				nr: observed(),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(&tc.args.cr, tc.args.nr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("e.TestIsUpToDate(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
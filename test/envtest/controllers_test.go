@@ -0,0 +1,288 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+	"go.openly.dev/pointy"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	policyv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/alertspolicy/v1alpha1"
+	cloudv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/cloud/v1alpha1"
+	dashboardv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/dashboard/v1alpha1"
+	keytransactionv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/keytransaction/v1alpha1"
+	conditionv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/nrqlalertcondition/v1alpha1"
+)
+
+func create(t *testing.T, mg resource.Managed) {
+	t.Helper()
+	if err := kube.Create(context.Background(), mg); err != nil {
+		t.Fatalf("Create(%s): %v", mg.GetName(), err)
+	}
+	eventually(t, "waiting for "+mg.GetName()+" to become ready", func(ctx context.Context) (bool, error) {
+		return ready(ctx, mg)
+	})
+}
+
+func remove(t *testing.T, mg resource.Managed) {
+	t.Helper()
+	if err := kube.Delete(context.Background(), mg); err != nil {
+		t.Fatalf("Delete(%s): %v", mg.GetName(), err)
+	}
+	eventually(t, "waiting for "+mg.GetName()+" to be deleted", func(ctx context.Context) (bool, error) {
+		return gone(ctx, mg)
+	})
+}
+
+func policy(name string) *policyv1alpha1.AlertsPolicy {
+	return &policyv1alpha1.AlertsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: policyv1alpha1.AlertsPolicySpec{
+			ForProvider: policyv1alpha1.AlertsPolicyParameters{
+				Name:               name,
+				IncidentPreference: "PER_POLICY",
+				ChannelIDs:         []int{},
+			},
+		},
+	}
+}
+
+func TestAlertsPolicy(t *testing.T) {
+	requireEnvtest(t)
+
+	cr := policy("envtest-policy")
+	create(t, cr)
+
+	id := cr.Spec.ForProvider.ID
+	if p, ok := server.Policy(id); !ok || p.Name != cr.Spec.ForProvider.Name {
+		t.Fatalf("Policy(%s): want policy %s to exist, got %+v", id, cr.Spec.ForProvider.Name, p)
+	}
+
+	// Drift is corrected
+	server.ModifyPolicy(id, func(p *alerts.AlertsPolicy) { p.Name = "changed by hand" })
+	eventually(t, "waiting for the policy name to be restored", func(_ context.Context) (bool, error) {
+		p, _ := server.Policy(id)
+		return p.Name == cr.Spec.ForProvider.Name, nil
+	})
+
+	remove(t, cr)
+	if _, ok := server.Policy(id); ok {
+		t.Errorf("Policy(%s): want policy to be deleted", id)
+	}
+}
+
+func TestNrqlAlertCondition(t *testing.T) {
+	requireEnvtest(t)
+
+	p := policy("envtest-condition-policy")
+	create(t, p)
+	defer remove(t, p)
+
+	cr := &conditionv1alpha1.NrqlAlertCondition{
+		ObjectMeta: metav1.ObjectMeta{Name: "envtest-condition"},
+		Spec: conditionv1alpha1.NrqlAlertConditionSpec{
+			ForProvider: conditionv1alpha1.NrqlAlertConditionParameters{
+				Name:            "envtest-condition",
+				Type:            "STATIC",
+				Enabled:         true,
+				Nrql:            conditionv1alpha1.Nrql{Query: "SELECT count(*) FROM Transaction"},
+				Signal:          conditionv1alpha1.Signal{FillOption: "NONE"},
+				Terms:           []conditionv1alpha1.NrqlConditionTerm{{Operator: "ABOVE", Priority: "CRITICAL", Threshold: "1", ThresholdDuration: 300, ThresholdOccurrences: "ALL"}},
+				AlertsPolicyRef: &xpv1.Reference{Name: p.GetName()},
+			},
+		},
+	}
+	create(t, cr)
+
+	id := cr.Spec.ForProvider.ID
+	c, ok := server.Condition(id)
+	if !ok || c.PolicyID != p.Spec.ForProvider.ID {
+		t.Fatalf("Condition(%s): want a condition of policy %s, got %+v", id, p.Spec.ForProvider.ID, c)
+	}
+
+	// Drift is corrected
+	server.ModifyCondition(id, func(c *alerts.NrqlAlertCondition) { c.Name = "changed by hand" })
+	eventually(t, "waiting for the condition name to be restored", func(_ context.Context) (bool, error) {
+		c, _ := server.Condition(id)
+		return c.Name == cr.Spec.ForProvider.Name, nil
+	})
+
+	remove(t, cr)
+	if _, ok := server.Condition(id); ok {
+		t.Errorf("Condition(%s): want condition to be deleted", id)
+	}
+}
+
+func TestDashboard(t *testing.T) {
+	requireEnvtest(t)
+
+	cr := &dashboardv1alpha1.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "envtest-dashboard"},
+		Spec: dashboardv1alpha1.DashboardSpec{
+			ForProvider: dashboardv1alpha1.DashboardParameters{
+				Name:        "envtest-dashboard",
+				Permissions: pointy.String("PUBLIC_READ_WRITE"),
+				Pages: []dashboardv1alpha1.DashboardPage{{
+					Name: "overview",
+					Widgets: []dashboardv1alpha1.DashboardWidget{{
+						Title:         "throughput",
+						Layout:        dashboardv1alpha1.DashboardWidgetLayout{Column: 1, Row: 1, Width: 4, Height: 3},
+						Visualization: dashboardv1alpha1.DashboardWidgetVisualization{ID: "viz.line"},
+						RawConfiguration: &dashboardv1alpha1.DashboardWidgetRawConfiguration{
+							NRQLQueries: &[]dashboardv1alpha1.DashboardWidgetNRQLQueryInput{{AccountID: accountID, Query: "SELECT count(*) FROM Transaction TIMESERIES"}},
+						},
+					}},
+				}},
+			},
+		},
+	}
+	create(t, cr)
+
+	guid := meta.GetExternalName(cr)
+	if cr.Spec.ForProvider.GUID != "" || cr.Spec.ForProvider.Pages[0].GUID != "" || cr.Spec.ForProvider.Pages[0].Widgets[0].ID != nil {
+		t.Errorf("Dashboard(%s): want the spec left as written, got %+v", guid, cr.Spec.ForProvider)
+	}
+	if d, ok := server.Dashboard(guid); !ok || d.Name != cr.Spec.ForProvider.Name {
+		t.Fatalf("Dashboard(%s): want dashboard %s to exist, got %+v", guid, cr.Spec.ForProvider.Name, d)
+	}
+
+	// Drift is corrected
+	server.ModifyDashboard(guid, func(d *dashboards.DashboardInput) { d.Name = "changed by hand" })
+	eventually(t, "waiting for the dashboard name to be restored", func(_ context.Context) (bool, error) {
+		d, _ := server.Dashboard(guid)
+		return d.Name == cr.Spec.ForProvider.Name, nil
+	})
+
+	remove(t, cr)
+	if _, ok := server.Dashboard(guid); ok {
+		t.Errorf("Dashboard(%s): want dashboard to be deleted", guid)
+	}
+}
+
+func TestKeyTransactionImmutableFields(t *testing.T) {
+	requireEnvtest(t)
+
+	ctx := context.Background()
+
+	// The in-memory NerdGraph has no key transactions, so the resource is only
+	// validated by the API server and orphaned when deleted
+	cr := &keytransactionv1alpha1.KeyTransaction{
+		ObjectMeta: metav1.ObjectMeta{Name: "envtest-key-transaction"},
+		Spec: keytransactionv1alpha1.KeyTransactionSpec{
+			ResourceSpec: xpv1.ResourceSpec{DeletionPolicy: xpv1.DeletionOrphan},
+			ForProvider: keytransactionv1alpha1.KeyTransactionParameters{
+				Name:               "envtest-key-transaction",
+				ApplicationGUID:    "MTIzNDU2N3xBUE18QVBQTElDQVRJT058MQ",
+				ApplicationName:    pointy.String("checkout-service"),
+				MetricName:         "WebTransaction/Controller/orders/create",
+				ApdexTarget:        0.5,
+				BrowserApdexTarget: 1,
+			},
+		},
+	}
+	if err := kube.Create(ctx, cr); err != nil {
+		t.Fatalf("Create(%s): %v", cr.GetName(), err)
+	}
+	defer kube.Delete(ctx, cr) //nolint:errcheck
+
+	cases := map[string]func(p *keytransactionv1alpha1.KeyTransactionParameters){
+		"ApplicationGUID": func(p *keytransactionv1alpha1.KeyTransactionParameters) {
+			p.ApplicationGUID = "MTIzNDU2N3xBUE18QVBQTElDQVRJT058Mg"
+		},
+		"ApplicationName": func(p *keytransactionv1alpha1.KeyTransactionParameters) {
+			p.ApplicationName = pointy.String("billing-service")
+		},
+		"MetricName": func(p *keytransactionv1alpha1.KeyTransactionParameters) {
+			p.MetricName = "WebTransaction/Controller/orders/update"
+		},
+	}
+
+	for name, change := range cases {
+		t.Run(name, func(t *testing.T) {
+			// The controller updates the resource too, so conflicts are retried
+			err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				got := &keytransactionv1alpha1.KeyTransaction{}
+				if err := kube.Get(ctx, types.NamespacedName{Name: cr.GetName()}, got); err != nil {
+					return err
+				}
+				change(&got.Spec.ForProvider)
+				return kube.Update(ctx, got)
+			})
+			if !kerrors.IsInvalid(err) {
+				t.Errorf("Update(%s): want the change to be rejected as invalid, got %v", cr.GetName(), err)
+			}
+		})
+	}
+}
+
+func TestCloudAwsLinkAccountImmutableFields(t *testing.T) {
+	requireEnvtest(t)
+
+	ctx := context.Background()
+
+	// The in-memory NerdGraph cannot link cloud accounts, so the resource is
+	// only validated by the API server and orphaned when deleted
+	cr := &cloudv1alpha1.CloudAwsLinkAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "envtest-aws-link-account"},
+		Spec: cloudv1alpha1.CloudAwsLinkAccountSpec{
+			ResourceSpec: xpv1.ResourceSpec{DeletionPolicy: xpv1.DeletionOrphan},
+			ForProvider: cloudv1alpha1.CloudAwsLinkAccountParameters{
+				Name: "envtest-aws-link-account",
+				Arn:  "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+			},
+		},
+	}
+	if err := kube.Create(ctx, cr); err != nil {
+		t.Fatalf("Create(%s): %v", cr.GetName(), err)
+	}
+	defer kube.Delete(ctx, cr) //nolint:errcheck
+
+	cases := map[string]func(p *cloudv1alpha1.CloudAwsLinkAccountParameters){
+		"Arn": func(p *cloudv1alpha1.CloudAwsLinkAccountParameters) {
+			p.Arn = "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Other"
+		},
+		"MetricCollectionMode": func(p *cloudv1alpha1.CloudAwsLinkAccountParameters) {
+			p.MetricCollectionMode = "PUSH"
+		},
+	}
+
+	for name, change := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				got := &cloudv1alpha1.CloudAwsLinkAccount{}
+				if err := kube.Get(ctx, types.NamespacedName{Name: cr.GetName()}, got); err != nil {
+					return err
+				}
+				change(&got.Spec.ForProvider)
+				return kube.Update(ctx, got)
+			})
+			if !kerrors.IsInvalid(err) {
+				t.Errorf("Update(%s): want the change to be rejected as invalid, got %v", cr.GetName(), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,498 @@
+// Package nerdgraph provides an in-memory stand-in of the New Relic
+// NerdGraph and REST APIs, so that tests can exercise the real client without
+// network access.
+package nerdgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+)
+
+const (
+	nerdGraphPath = "/graphql"
+	restPath      = "/v2"
+)
+
+// A Server answers the alerts policy, NRQL condition and dashboard queries and
+// mutations of the New Relic client from in-memory state.
+type Server struct {
+	*httptest.Server
+
+	// AccountID and AccountName are the account the API key has access to
+	AccountID   int
+	AccountName string
+
+	mu         sync.Mutex
+	nextID     int
+	calls      map[string]int
+	policies   map[string]*alerts.AlertsPolicy
+	conditions map[string]*alerts.NrqlAlertCondition
+	dashboards map[string]*dashboard
+}
+
+type dashboard struct {
+	accountID int
+	input     dashboards.DashboardInput
+}
+
+type gqlError struct {
+	Message    string            `json:"message"`
+	Extensions map[string]string `json:"extensions,omitempty"`
+}
+
+// notFound is how the alerts API reports a missing policy or condition
+func notFound() *gqlError {
+	return &gqlError{Message: "Not Found", Extensions: map[string]string{"errorClass": "BAD_USER_INPUT"}}
+}
+
+type variables map[string]json.RawMessage
+
+func (v variables) string(name string) string {
+	var s string
+	if err := json.Unmarshal(v[name], &s); err != nil {
+		// IDs are sometimes sent as numbers
+		return strings.Trim(string(v[name]), `"`)
+	}
+	return s
+}
+
+func (v variables) int(name string) int {
+	i, _ := strconv.Atoi(v.string(name))
+	return i
+}
+
+// An operation answers the GraphQL root field it is named after
+type operation struct {
+	field  string
+	handle func(s *Server, v variables) (interface{}, *gqlError)
+}
+
+// Mutations come first, as do the longer of two fields sharing a prefix, since
+// queries are matched on the first field they contain.
+var operations = []operation{
+	{field: "alertsPolicyCreate", handle: (*Server).createPolicy},
+	{field: "alertsPolicyUpdate", handle: (*Server).updatePolicy},
+	{field: "alertsPolicyDelete", handle: (*Server).deletePolicy},
+	{field: "alertsNrqlConditionStaticCreate", handle: conditionCreator("alertsNrqlConditionStaticCreate", "STATIC")},
+	{field: "alertsNrqlConditionBaselineCreate", handle: conditionCreator("alertsNrqlConditionBaselineCreate", "BASELINE")},
+	{field: "alertsNrqlConditionStaticUpdate", handle: conditionUpdater("alertsNrqlConditionStaticUpdate")},
+	{field: "alertsNrqlConditionBaselineUpdate", handle: conditionUpdater("alertsNrqlConditionBaselineUpdate")},
+	{field: "alertsConditionDelete", handle: (*Server).deleteCondition},
+	{field: "dashboardCreate", handle: (*Server).createDashboard},
+	{field: "dashboardUpdate", handle: (*Server).updateDashboard},
+	{field: "dashboardDelete", handle: (*Server).deleteDashboard},
+	{field: "nrqlConditionsSearch", handle: (*Server).searchConditions},
+	{field: "nrqlCondition", handle: (*Server).getCondition},
+	{field: "policy", handle: (*Server).getPolicy},
+	{field: "entity", handle: (*Server).getEntity},
+	{field: "account", handle: (*Server).getAccount},
+}
+
+var fieldPatterns = func() map[string]*regexp.Regexp {
+	p := make(map[string]*regexp.Regexp, len(operations))
+	for _, o := range operations {
+		p[o.field] = regexp.MustCompile(`\b` + o.field + `\s*\(`)
+	}
+	return p
+}()
+
+// NewServer starts a Server for an account. Callers must Close it.
+func NewServer(accountID int) *Server {
+	s := &Server{
+		AccountID:   accountID,
+		AccountName: "Test Account",
+		calls:       map[string]int{},
+		policies:    map[string]*alerts.AlertsPolicy{},
+		conditions:  map[string]*alerts.NrqlAlertCondition{},
+		dashboards:  map[string]*dashboard{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(nerdGraphPath, s.serveNerdGraph)
+	mux.HandleFunc(restPath+"/alerts_policies.json", s.listPolicies)
+	mux.HandleFunc(restPath+"/alerts_policy_channels.json", s.updatePolicyChannels)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// NerdGraphURL is the NerdGraph endpoint of the server
+func (s *Server) NerdGraphURL() string {
+	return s.URL + nerdGraphPath
+}
+
+// RESTURL is the REST v2 base URL of the server
+func (s *Server) RESTURL() string {
+	return s.URL + restPath
+}
+
+// Calls returns how often a NerdGraph root field, like alertsPolicyUpdate, or
+// a REST path, like /alerts_policies.json, was requested.
+func (s *Server) Calls(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[operation]
+}
+
+// Policy returns a copy of a policy
+func (s *Server) Policy(id string) (alerts.AlertsPolicy, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.policies[id]
+	if !ok {
+		return alerts.AlertsPolicy{}, false
+	}
+	return *p, true
+}
+
+// ModifyPolicy changes a policy out of band, as someone using the UI would
+func (s *Server) ModifyPolicy(id string, f func(p *alerts.AlertsPolicy)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.policies[id]
+	if ok {
+		f(p)
+	}
+	return ok
+}
+
+// Condition returns a copy of a NRQL condition
+func (s *Server) Condition(id string) (alerts.NrqlAlertCondition, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.conditions[id]
+	if !ok {
+		return alerts.NrqlAlertCondition{}, false
+	}
+	return *c, true
+}
+
+// ModifyCondition changes a NRQL condition out of band
+func (s *Server) ModifyCondition(id string, f func(c *alerts.NrqlAlertCondition)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.conditions[id]
+	if ok {
+		f(c)
+	}
+	return ok
+}
+
+// Dashboard returns a copy of the input a dashboard was last written with,
+// with the GUIDs and IDs the server assigned.
+func (s *Server) Dashboard(guid string) (dashboards.DashboardInput, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.dashboards[guid]
+	if !ok {
+		return dashboards.DashboardInput{}, false
+	}
+	return d.input, true
+}
+
+// ModifyDashboard changes a dashboard out of band
+func (s *Server) ModifyDashboard(guid string, f func(d *dashboards.DashboardInput)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.dashboards[guid]
+	if ok {
+		f(&d.input)
+	}
+	return ok
+}
+
+func (s *Server) id() string {
+	s.nextID++
+	return strconv.Itoa(s.nextID)
+}
+
+func (s *Server) guid(domain, typ string) string {
+	return fmt.Sprintf("%d|%s|%s|%s", s.AccountID, domain, typ, s.id())
+}
+
+func (s *Server) serveNerdGraph(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		Query     string    `json:"query"`
+		Variables variables `json:"variables"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, o := range operations {
+		if !fieldPatterns[o.field].MatchString(req.Query) {
+			continue
+		}
+		s.mu.Lock()
+		s.calls[o.field]++
+		data, gerr := o.handle(s, req.Variables)
+		s.mu.Unlock()
+
+		resp := map[string]interface{}{"data": data}
+		if gerr != nil {
+			resp["errors"] = []*gqlError{gerr}
+		}
+		writeJSON(w, resp)
+		return
+	}
+	writeJSON(w, map[string]interface{}{"errors": []gqlError{{Message: "unsupported query"}}})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (s *Server) createPolicy(v variables) (interface{}, *gqlError) {
+	p := &alerts.AlertsPolicy{}
+	if err := json.Unmarshal(v["policy"], p); err != nil {
+		return nil, &gqlError{Message: err.Error()}
+	}
+	p.ID = s.id()
+	p.AccountID = v.int("accountID")
+	s.policies[p.ID] = p
+	return map[string]interface{}{"alertsPolicyCreate": p}, nil
+}
+
+func (s *Server) updatePolicy(v variables) (interface{}, *gqlError) {
+	p, ok := s.policies[v.string("policyID")]
+	if !ok {
+		return nil, notFound()
+	}
+	if err := json.Unmarshal(v["policy"], p); err != nil {
+		return nil, &gqlError{Message: err.Error()}
+	}
+	return map[string]interface{}{"alertsPolicyUpdate": p}, nil
+}
+
+func (s *Server) deletePolicy(v variables) (interface{}, *gqlError) {
+	id := v.string("policyID")
+	if _, ok := s.policies[id]; !ok {
+		return nil, notFound()
+	}
+	delete(s.policies, id)
+	// The conditions of a policy go with it
+	for cid, c := range s.conditions {
+		if c.PolicyID == id {
+			delete(s.conditions, cid)
+		}
+	}
+	return map[string]interface{}{"alertsPolicyDelete": map[string]string{"id": id}}, nil
+}
+
+func (s *Server) getPolicy(v variables) (interface{}, *gqlError) {
+	p, ok := s.policies[v.string("policyID")]
+	if !ok {
+		return nil, notFound()
+	}
+	return actorAccount(map[string]interface{}{"alerts": map[string]interface{}{"policy": p}}), nil
+}
+
+func actorAccount(account interface{}) map[string]interface{} {
+	return map[string]interface{}{"actor": map[string]interface{}{"account": account}}
+}
+
+func conditionCreator(field, conditionType string) func(s *Server, v variables) (interface{}, *gqlError) {
+	return func(s *Server, v variables) (interface{}, *gqlError) {
+		policyID := v.string("policyId")
+		if _, ok := s.policies[policyID]; !ok {
+			return nil, &gqlError{Message: fmt.Sprintf("Policy with ID %s not found", policyID)}
+		}
+		c := &alerts.NrqlAlertCondition{}
+		if err := json.Unmarshal(v["condition"], c); err != nil {
+			return nil, &gqlError{Message: err.Error()}
+		}
+		c.ID = s.id()
+		c.PolicyID = policyID
+		c.Type = alerts.NrqlConditionType(conditionType)
+		c.EntityGUID = common.EntityGUID(s.guid("AIOPS", "CONDITION"))
+		s.conditions[c.ID] = c
+		return map[string]interface{}{field: c}, nil
+	}
+}
+
+func conditionUpdater(field string) func(s *Server, v variables) (interface{}, *gqlError) {
+	return func(s *Server, v variables) (interface{}, *gqlError) {
+		c, ok := s.conditions[v.string("id")]
+		if !ok {
+			return nil, notFound()
+		}
+		if err := json.Unmarshal(v["condition"], c); err != nil {
+			return nil, &gqlError{Message: err.Error()}
+		}
+		return map[string]interface{}{field: c}, nil
+	}
+}
+
+func (s *Server) deleteCondition(v variables) (interface{}, *gqlError) {
+	id := v.string("id")
+	if _, ok := s.conditions[id]; !ok {
+		return nil, notFound()
+	}
+	delete(s.conditions, id)
+	return map[string]interface{}{"alertsConditionDelete": map[string]string{"id": id}}, nil
+}
+
+func (s *Server) getCondition(v variables) (interface{}, *gqlError) {
+	c, ok := s.conditions[v.string("id")]
+	if !ok {
+		return nil, notFound()
+	}
+	return actorAccount(map[string]interface{}{"alerts": map[string]interface{}{"nrqlCondition": c}}), nil
+}
+
+func (s *Server) searchConditions(v variables) (interface{}, *gqlError) {
+	criteria := alerts.NrqlConditionsSearchCriteria{}
+	if err := json.Unmarshal(v["searchCriteria"], &criteria); err != nil {
+		return nil, &gqlError{Message: err.Error()}
+	}
+	found := []*alerts.NrqlAlertCondition{}
+	for _, c := range s.conditions {
+		if criteria.Name != "" && c.Name != criteria.Name {
+			continue
+		}
+		if criteria.PolicyID != "" && c.PolicyID != criteria.PolicyID {
+			continue
+		}
+		found = append(found, c)
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].ID < found[j].ID })
+	return actorAccount(map[string]interface{}{"alerts": map[string]interface{}{
+		"nrqlConditionsSearch": map[string]interface{}{"nextCursor": nil, "nrqlConditions": found},
+	}}), nil
+}
+
+// assignIDs gives the pages and widgets of a dashboard that have none an identity
+func (s *Server) assignIDs(input *dashboards.DashboardInput) {
+	for p := range input.Pages {
+		if input.Pages[p].GUID == "" {
+			input.Pages[p].GUID = common.EntityGUID(s.guid("VIZ", "DASHBOARD"))
+		}
+		for w := range input.Pages[p].Widgets {
+			if input.Pages[p].Widgets[w].ID == "" {
+				input.Pages[p].Widgets[w].ID = s.id()
+			}
+		}
+	}
+}
+
+// entity renders a dashboard the way the entity and mutation results show it
+func (s *Server) entity(guid string, d *dashboard) map[string]interface{} {
+	b, _ := json.Marshal(d.input)
+	e := map[string]interface{}{}
+	_ = json.Unmarshal(b, &e)
+	e["__typename"] = "DashboardEntity"
+	e["guid"] = guid
+	e["accountId"] = d.accountID
+	e["permalink"] = "https://one.newrelic.com/redirect/entity/" + guid
+	return e
+}
+
+func dashboardErrors(field, description string) map[string]interface{} {
+	return map[string]interface{}{field: map[string]interface{}{
+		"errors": []map[string]string{{"description": description, "type": "DASHBOARD_NOT_FOUND"}},
+	}}
+}
+
+func (s *Server) createDashboard(v variables) (interface{}, *gqlError) {
+	d := &dashboard{accountID: v.int("accountId")}
+	if err := json.Unmarshal(v["dashboard"], &d.input); err != nil {
+		return nil, &gqlError{Message: err.Error()}
+	}
+	s.assignIDs(&d.input)
+	guid := s.guid("VIZ", "DASHBOARD")
+	s.dashboards[guid] = d
+	return map[string]interface{}{"dashboardCreate": map[string]interface{}{"entityResult": s.entity(guid, d)}}, nil
+}
+
+func (s *Server) updateDashboard(v variables) (interface{}, *gqlError) {
+	guid := v.string("guid")
+	d, ok := s.dashboards[guid]
+	if !ok {
+		return dashboardErrors("dashboardUpdate", "Dashboard not found"), nil
+	}
+	input := dashboards.DashboardInput{}
+	if err := json.Unmarshal(v["dashboard"], &input); err != nil {
+		return nil, &gqlError{Message: err.Error()}
+	}
+	s.assignIDs(&input)
+	d.input = input
+	return map[string]interface{}{"dashboardUpdate": map[string]interface{}{"entityResult": s.entity(guid, d)}}, nil
+}
+
+func (s *Server) deleteDashboard(v variables) (interface{}, *gqlError) {
+	guid := v.string("guid")
+	if _, ok := s.dashboards[guid]; !ok {
+		return dashboardErrors("dashboardDelete", "Dashboard not found"), nil
+	}
+	delete(s.dashboards, guid)
+	return map[string]interface{}{"dashboardDelete": map[string]interface{}{"status": "SUCCESS"}}, nil
+}
+
+func (s *Server) getEntity(v variables) (interface{}, *gqlError) {
+	guid := v.string("guid")
+	var entity interface{}
+	if d, ok := s.dashboards[guid]; ok {
+		entity = s.entity(guid, d)
+	}
+	return map[string]interface{}{"actor": map[string]interface{}{"entity": entity}}, nil
+}
+
+func (s *Server) getAccount(v variables) (interface{}, *gqlError) {
+	var account interface{}
+	if v.int("accountId") == s.AccountID {
+		account = map[string]interface{}{"id": s.AccountID, "name": s.AccountName}
+	}
+	return map[string]interface{}{"actor": map[string]interface{}{
+		"user":    map[string]interface{}{"id": 1, "email": "test@example.com"},
+		"account": account,
+	}}, nil
+}
+
+func (s *Server) listPolicies(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["/alerts_policies.json"]++
+
+	name := r.URL.Query().Get("filter[name]")
+	policies := []alerts.Policy{}
+	for _, p := range s.policies {
+		if name != "" && p.Name != name {
+			continue
+		}
+		id, _ := strconv.Atoi(p.ID)
+		policies = append(policies, alerts.Policy{ID: id, Name: p.Name, IncidentPreference: alerts.IncidentPreferenceType(p.IncidentPreference)})
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].ID < policies[j].ID })
+	writeJSON(w, map[string]interface{}{"policies": policies})
+}
+
+func (s *Server) updatePolicyChannels(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["/alerts_policy_channels.json"]++
+
+	id := r.URL.Query().Get("policy_id")
+	if _, ok := s.policies[id]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	policyID, _ := strconv.Atoi(id)
+	channels := alerts.PolicyChannels{ID: policyID}
+	for _, c := range strings.Split(r.URL.Query().Get("channel_ids"), ",") {
+		if i, err := strconv.Atoi(c); err == nil {
+			channels.ChannelIDs = append(channels.ChannelIDs, i)
+		}
+	}
+	writeJSON(w, map[string]interface{}{"policy": channels})
+}
@@ -0,0 +1,188 @@
+package nerdgraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+
+	nr "github.com/crossplane-contrib/provider-newrelic/pkg/clients"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/clients/nrerrors"
+)
+
+const accountID = 1234567
+
+func newClient(t *testing.T, s *Server) *newrelic.NewRelic {
+	t.Helper()
+	client, err := nr.GetNewRelicClient("NRAK-TEST", nil,
+		newrelic.ConfigNerdGraphBaseURL(s.NerdGraphURL()),
+		newrelic.ConfigBaseURL(s.RESTURL()),
+	)
+	if err != nil {
+		t.Fatalf("GetNewRelicClient(...): %v", err)
+	}
+	return client
+}
+
+func TestPolicies(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(accountID)
+	defer s.Close()
+	client := newClient(t, s)
+
+	created, err := client.Alerts.CreatePolicyMutationWithContext(ctx, accountID, alerts.AlertsPolicyInput{Name: "test", IncidentPreference: alerts.AlertsIncidentPreferenceTypes.PER_POLICY})
+	if err != nil {
+		t.Fatalf("CreatePolicyMutationWithContext(...): %v", err)
+	}
+
+	got, err := client.Alerts.QueryPolicyWithContext(ctx, accountID, created.ID)
+	if err != nil {
+		t.Fatalf("QueryPolicyWithContext(...): %v", err)
+	}
+	if diff := cmp.Diff(created, got); diff != "" {
+		t.Errorf("QueryPolicyWithContext(...): -want, +got:\n%s\n", diff)
+	}
+
+	listed, err := client.Alerts.ListPoliciesWithContext(ctx, &alerts.ListPoliciesParams{Name: "test"})
+	if err != nil {
+		t.Fatalf("ListPoliciesWithContext(...): %v", err)
+	}
+	if len(listed) != 1 || listed[0].Name != "test" {
+		t.Errorf("ListPoliciesWithContext(...): want the created policy, got %v", listed)
+	}
+
+	if _, err := client.Alerts.UpdatePolicyMutationWithContext(ctx, accountID, created.ID, alerts.AlertsPolicyUpdateInput{Name: "renamed", IncidentPreference: alerts.AlertsIncidentPreferenceTypes.PER_POLICY}); err != nil {
+		t.Fatalf("UpdatePolicyMutationWithContext(...): %v", err)
+	}
+	if p, _ := s.Policy(created.ID); p.Name != "renamed" {
+		t.Errorf("UpdatePolicyMutationWithContext(...): want name renamed, got %s", p.Name)
+	}
+
+	if _, err := client.Alerts.DeletePolicyMutationWithContext(ctx, accountID, created.ID); err != nil {
+		t.Fatalf("DeletePolicyMutationWithContext(...): %v", err)
+	}
+	if _, err := client.Alerts.QueryPolicyWithContext(ctx, accountID, created.ID); !nrerrors.IsNotFound(err) {
+		t.Errorf("QueryPolicyWithContext(...): want not found, got %v", err)
+	}
+	// The client only reports a missing policy as not found when it is queried
+	if _, err := client.Alerts.DeletePolicyMutationWithContext(ctx, accountID, created.ID); !nrerrors.IsValidation(err) {
+		t.Errorf("DeletePolicyMutationWithContext(...): want a validation error, got %v", err)
+	}
+	if diff := cmp.Diff(2, s.Calls("alertsPolicyDelete")); diff != "" {
+		t.Errorf("Calls(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestNrqlConditions(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(accountID)
+	defer s.Close()
+	client := newClient(t, s)
+
+	input := alerts.NrqlConditionCreateInput{NrqlConditionCreateBase: alerts.NrqlConditionCreateBase{
+		Name:    "test",
+		Enabled: true,
+		Nrql:    alerts.NrqlConditionCreateQuery{Query: "SELECT count(*) FROM Transaction"},
+	}}
+	if _, err := client.Alerts.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, "404", input); err == nil {
+		t.Errorf("CreateNrqlConditionStaticMutationWithContext(...): want an error for a missing policy")
+	}
+
+	policy, err := client.Alerts.CreatePolicyMutationWithContext(ctx, accountID, alerts.AlertsPolicyInput{Name: "test"})
+	if err != nil {
+		t.Fatalf("CreatePolicyMutationWithContext(...): %v", err)
+	}
+	created, err := client.Alerts.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, policy.ID, input)
+	if err != nil {
+		t.Fatalf("CreateNrqlConditionStaticMutationWithContext(...): %v", err)
+	}
+	if created.Type != alerts.NrqlConditionTypes.Static || created.PolicyID != policy.ID {
+		t.Errorf("CreateNrqlConditionStaticMutationWithContext(...): want a static condition of the policy, got %+v", created)
+	}
+
+	got, err := client.Alerts.GetNrqlConditionQueryWithContext(ctx, accountID, created.ID)
+	if err != nil {
+		t.Fatalf("GetNrqlConditionQueryWithContext(...): %v", err)
+	}
+	if diff := cmp.Diff(created, got); diff != "" {
+		t.Errorf("GetNrqlConditionQueryWithContext(...): -want, +got:\n%s\n", diff)
+	}
+
+	found, err := client.Alerts.SearchNrqlConditionsQueryWithContext(ctx, accountID, alerts.NrqlConditionsSearchCriteria{Name: "test", PolicyID: policy.ID})
+	if err != nil {
+		t.Fatalf("SearchNrqlConditionsQueryWithContext(...): %v", err)
+	}
+	if len(found) != 1 || found[0].ID != created.ID {
+		t.Errorf("SearchNrqlConditionsQueryWithContext(...): want the created condition, got %v", found)
+	}
+
+	if _, err := client.Alerts.DeleteNrqlConditionMutationWithContext(ctx, accountID, created.ID); err != nil {
+		t.Fatalf("DeleteNrqlConditionMutationWithContext(...): %v", err)
+	}
+	if _, err := client.Alerts.GetNrqlConditionQueryWithContext(ctx, accountID, created.ID); !nrerrors.IsNotFound(err) {
+		t.Errorf("GetNrqlConditionQueryWithContext(...): want not found, got %v", err)
+	}
+}
+
+func TestDashboards(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(accountID)
+	defer s.Close()
+	client := newClient(t, s)
+
+	input := dashboards.DashboardInput{
+		Name:        "test",
+		Permissions: entities.DashboardPermissionsTypes.PUBLIC_READ_WRITE,
+		Pages: []dashboards.DashboardPageInput{{
+			Name: "page",
+			Widgets: []dashboards.DashboardWidgetInput{{
+				Title:         "widget",
+				Layout:        dashboards.DashboardWidgetLayoutInput{Column: 1, Row: 1, Width: 4, Height: 3},
+				Visualization: dashboards.DashboardWidgetVisualizationInput{ID: "viz.area"},
+			}},
+		}},
+	}
+	created, err := client.Dashboards.DashboardCreateWithContext(ctx, accountID, input)
+	if err != nil {
+		t.Fatalf("DashboardCreateWithContext(...): %v", err)
+	}
+	guid := created.EntityResult.GUID
+	if guid == "" || len(created.EntityResult.Pages) != 1 || created.EntityResult.Pages[0].GUID == "" || created.EntityResult.Pages[0].Widgets[0].ID == "" {
+		t.Fatalf("DashboardCreateWithContext(...): want GUIDs and IDs to be assigned, got %+v", created.EntityResult)
+	}
+
+	got, err := client.Dashboards.GetDashboardEntityWithContext(ctx, guid)
+	if err != nil {
+		t.Fatalf("GetDashboardEntityWithContext(...): %v", err)
+	}
+	if diff := cmp.Diff(created.EntityResult.Pages[0].GUID, got.Pages[0].GUID); diff != "" {
+		t.Errorf("GetDashboardEntityWithContext(...): -want page guid, +got page guid:\n%s\n", diff)
+	}
+	if got.Name != "test" || got.Permalink == "" {
+		t.Errorf("GetDashboardEntityWithContext(...): want the created dashboard, got %+v", got)
+	}
+
+	input.Name = "renamed"
+	input.Pages[0].GUID = created.EntityResult.Pages[0].GUID
+	if _, err := client.Dashboards.DashboardUpdateWithContext(ctx, input, guid); err != nil {
+		t.Fatalf("DashboardUpdateWithContext(...): %v", err)
+	}
+	if d, _ := s.Dashboard(string(guid)); d.Name != "renamed" || d.Pages[0].GUID != created.EntityResult.Pages[0].GUID {
+		t.Errorf("DashboardUpdateWithContext(...): want the dashboard renamed with its page kept, got %+v", d)
+	}
+
+	if _, err := client.Dashboards.DashboardDeleteWithContext(ctx, guid); err != nil {
+		t.Fatalf("DashboardDeleteWithContext(...): %v", err)
+	}
+	if _, err := client.Dashboards.GetDashboardEntityWithContext(ctx, guid); !nrerrors.IsNotFound(err) {
+		t.Errorf("GetDashboardEntityWithContext(...): want not found, got %v", err)
+	}
+	if _, err := client.Dashboards.DashboardDeleteWithContext(ctx, common.EntityGUID("missing")); err == nil {
+		t.Errorf("DashboardDeleteWithContext(...): want an error for a missing dashboard")
+	}
+}
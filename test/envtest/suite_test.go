@@ -0,0 +1,215 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envtest runs the controllers against a local kube-apiserver and an
+// in-memory NerdGraph. The envtest binaries must be installed, with
+// KUBEBUILDER_ASSETS pointing at them, or each test is skipped.
+package envtest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"go.openly.dev/pointy"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/crossplane-contrib/provider-newrelic/apis"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-newrelic/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-newrelic/pkg/controller"
+	"github.com/crossplane-contrib/provider-newrelic/test/envtest/nerdgraph"
+)
+
+const (
+	accountID = 1234567
+	namespace = "crossplane-system"
+
+	// How long to wait for the controllers to converge
+	timeout = 30 * time.Second
+	// How often the controllers check for drift
+	pollInterval = time.Second
+)
+
+var (
+	kube   client.Client
+	server *nerdgraph.Server
+
+	// skipReason is why the suite cannot run, reported by every test it skips
+	skipReason string
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		skipReason = "KUBEBUILDER_ASSETS is not set, run make test-envtest with the envtest binaries installed"
+		os.Exit(m.Run())
+	}
+	os.Exit(run(m))
+}
+
+// requireEnvtest skips a test when the envtest binaries are not installed
+func requireEnvtest(t *testing.T) {
+	t.Helper()
+	if skipReason != "" {
+		t.Skip(skipReason)
+	}
+}
+
+func run(m *testing.M) int {
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "package", "crds")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot start envtest: %v\n", err)
+		return 1
+	}
+	defer env.Stop() //nolint:errcheck
+
+	server = nerdgraph.NewServer(accountID)
+	defer server.Close()
+
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot add Kubernetes APIs to scheme: %v\n", err)
+		return 1
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot add NewRelic APIs to scheme: %v\n", err)
+		return 1
+	}
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:  s,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot create controller manager: %v\n", err)
+		return 1
+	}
+	o := xpcontroller.Options{
+		Logger:                  logging.NewNopLogger(),
+		MaxConcurrentReconciles: 1,
+		PollInterval:            pollInterval,
+		GlobalRateLimiter:       ratelimiter.NewGlobal(100),
+		Features:                &feature.Flags{},
+	}
+	if err := controller.Setup(mgr, o); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot setup NewRelic controllers: %v\n", err)
+		return 1
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "cannot start controller manager: %v\n", err)
+		}
+	}()
+
+	// Tests read through the API server rather than the manager's cache
+	kube, err = client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot create client: %v\n", err)
+		return 1
+	}
+	if err := createProviderConfig(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot create ProviderConfig: %v\n", err)
+		return 1
+	}
+
+	return m.Run()
+}
+
+// createProviderConfig points the default ProviderConfig at the fake NerdGraph
+func createProviderConfig(ctx context.Context) error {
+	objs := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "newrelic-creds"},
+			StringData: map[string]string{"credentials": "NRAK-TEST"},
+		},
+		&apisv1alpha1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: apisv1alpha1.ProviderConfigSpec{
+				Credentials: apisv1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: namespace, Name: "newrelic-creds"},
+						Key:             "credentials",
+					}},
+				},
+				AccountID: strconv.Itoa(accountID),
+				Endpoints: &apisv1alpha1.ProviderEndpoints{
+					NerdGraph: pointy.String(server.NerdGraphURL()),
+					REST:      pointy.String(server.RESTURL()),
+				},
+			},
+		},
+	}
+	for _, o := range objs {
+		if err := kube.Create(ctx, o); resource.Ignore(kerrors.IsAlreadyExists, err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventually polls until a condition holds, failing the test when it times out
+func eventually(t *testing.T, what string, condition func(ctx context.Context) (bool, error)) {
+	t.Helper()
+	if err := wait.PollUntilContextTimeout(context.Background(), 100*time.Millisecond, timeout, true, condition); err != nil {
+		t.Fatalf("%s: %v", what, err)
+	}
+}
+
+// ready returns whether a managed resource is Ready and Synced
+func ready(ctx context.Context, mg resource.Managed) (bool, error) {
+	if err := kube.Get(ctx, types.NamespacedName{Name: mg.GetName()}, mg); err != nil {
+		return false, err
+	}
+	return mg.GetCondition(xpv1.TypeReady).Status == corev1.ConditionTrue &&
+		mg.GetCondition(xpv1.TypeSynced).Status == corev1.ConditionTrue, nil
+}
+
+// gone returns whether a managed resource has been deleted
+func gone(ctx context.Context, mg resource.Managed) (bool, error) {
+	err := kube.Get(ctx, types.NamespacedName{Name: mg.GetName()}, mg)
+	if kerrors.IsNotFound(err) {
+		return true, nil
+	}
+	return false, err
+}